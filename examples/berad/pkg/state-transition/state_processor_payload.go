@@ -116,7 +116,9 @@ func (sp *StateProcessor[
 			payload,
 			body.GetBlobKzgCommitments().ToVersionedHashes(),
 			&parentBeaconBlockRoot,
+			nil,
 			optimisticEngine,
+			sp.cs.ActiveForkVersionForSlot(blk.GetSlot().Unwrap()),
 		),
 	); err != nil {
 		return err
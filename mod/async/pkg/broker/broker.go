@@ -22,28 +22,34 @@ package broker
 
 import (
 	"context"
-	"time"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/types"
 )
 
-// Broker broadcasts msgs to registered clients.
-type Broker[T any] struct {
+// Broker broadcasts msgs to registered clients. Each client may restrict
+// the event types it receives and chooses how the broker behaves when the
+// client falls behind.
+type Broker[T Event] struct {
 	// name of the message broker.
 	name string
-	// clients is a map of registered clients.
-	clients map[chan T]struct{}
+	// mu protects subscriptions.
+	mu sync.RWMutex
+	// subscriptions maps each client channel to its subscription.
+	subscriptions map[chan T]*subscription[T]
 	// msgs is the channel for publishing new messages.
 	msgs chan T
-	// timeout is the timeout for sending a msg to a client.
-	timeout time.Duration
+	// sink is the telemetry sink dropped messages are reported to.
+	sink TelemetrySink
 }
 
 // New creates a new b.
-func New[T any](name string) *Broker[T] {
+func New[T Event](name string, sink TelemetrySink) *Broker[T] {
 	return &Broker[T]{
-		clients: make(map[chan T]struct{}),
-		msgs:    make(chan T, defaultBufferSize),
-		timeout: defaultTimeout,
-		name:    name,
+		name:          name,
+		subscriptions: make(map[chan T]*subscription[T]),
+		msgs:          make(chan T, defaultBufferSize),
+		sink:          sink,
 	}
 }
 
@@ -64,25 +70,50 @@ func (b *Broker[T]) start(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			// close all leftover clients and break the broker loop
-			for client := range b.clients {
-				b.Unsubscribe(client)
+			b.mu.Lock()
+			subs := b.subscriptions
+			b.subscriptions = make(map[chan T]*subscription[T])
+			b.mu.Unlock()
+			for _, sub := range subs {
+				sub.close()
 			}
 			return
 		case msg := <-b.msgs:
-			// broadcast published msg to all clients
-			for client := range b.clients {
-				// send msg to client (or discard msg after timeout)
-				select {
-				case client <- msg:
-				case <-time.After(b.timeout):
-				}
-			}
+			b.broadcast(ctx, msg)
+		}
+	}
+}
+
+// broadcast delivers msg to every client that registered for its type.
+func (b *Broker[T]) broadcast(ctx context.Context, msg T) {
+	// Deliver to a snapshot of the subscriptions, so that clients can
+	// unsubscribe while a delivery is blocked.
+	b.mu.RLock()
+	subs := make([]*subscription[T], 0, len(b.subscriptions))
+	for _, sub := range b.subscriptions {
+		if sub.accepts(msg) {
+			subs = append(subs, sub)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, sub := range subs {
+		dropped, disconnect := sub.deliver(ctx, msg)
+		if dropped {
+			b.sink.IncrementCounter(
+				droppedMessagesMetric,
+				"broker", b.name,
+				"event", string(msg.Type()),
+				"policy", sub.policy.String(),
+			)
+		}
+		if disconnect {
+			b.Unsubscribe(sub.ch)
 		}
 	}
 }
 
 // Publish publishes a msg to the b.
-// Returns ErrTimeout on timeout.
 func (b *Broker[T]) Publish(ctx context.Context, msg T) error {
 	select {
 	case b.msgs <- msg:
@@ -92,19 +123,54 @@ func (b *Broker[T]) Publish(ctx context.Context, msg T) error {
 	}
 }
 
-// Subscribe registers a new client to the broker and returns it to the caller.
-// Returns ErrTimeout on timeout.
+// Subscribe registers a new client to the broker and returns it to the
+// caller. The client receives every event and blocks the broker for up to a
+// second when it falls behind.
 func (b *Broker[T]) Subscribe() (chan T, error) {
-	client := make(chan T)
-	b.clients[client] = struct{}{}
-	return client, nil
+	return b.SubscribeWithOptions()
 }
 
-// Unsubscribe removes a client from the b.
-// Returns ErrTimeout on timeout.
+// SubscribeWithOptions registers a new client configured by the given
+// options to the broker and returns it to the caller.
+func (b *Broker[T]) SubscribeWithOptions(
+	opts ...SubscriptionOption,
+) (chan T, error) {
+	cfg := subscriptionConfig{
+		eventIDs:   make(map[types.EventID]struct{}),
+		policy:     PolicyBlock,
+		bufferSize: defaultBufferSize,
+		timeout:    defaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	switch {
+	case cfg.policy > PolicyDisconnect:
+		return nil, ErrUnknownPolicy
+	case cfg.bufferSize < 0,
+		cfg.bufferSize == 0 && cfg.policy != PolicyBlock:
+		return nil, ErrInvalidBufferSize
+	case cfg.timeout < 0:
+		return nil, ErrInvalidTimeout
+	}
+
+	sub := newSubscription[T](cfg)
+	b.mu.Lock()
+	b.subscriptions[sub.ch] = sub
+	b.mu.Unlock()
+	return sub.ch, nil
+}
+
+// Unsubscribe removes a client from the b and closes its channel. It is
+// safe to call Unsubscribe from any goroutine and more than once for the
+// same client.
 func (b *Broker[T]) Unsubscribe(client chan T) {
-	// Remove the client from the broker
-	delete(b.clients, client)
-	// close the client channel
-	close(client)
+	b.mu.Lock()
+	sub, ok := b.subscriptions[client]
+	delete(b.subscriptions, client)
+	b.mu.Unlock()
+	if ok {
+		sub.close()
+	}
 }
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	eventA types.EventID = "a"
+	eventB types.EventID = "b"
+)
+
+type testEvent = types.Event[int]
+
+type countingSink struct {
+	count atomic.Int64
+}
+
+func (s *countingSink) IncrementCounter(string, ...string) {
+	s.count.Add(1)
+}
+
+func newEvent(id types.EventID, data int) *testEvent {
+	return types.NewEvent(context.Background(), id, data)
+}
+
+func startBroker(
+	t *testing.T,
+) (*broker.Broker[*testEvent], *countingSink) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	sink := &countingSink{}
+	b := broker.New[*testEvent]("test", sink)
+	require.NoError(t, b.Start(ctx))
+	return b, sink
+}
+
+func receive(t *testing.T, ch chan *testEvent) *testEvent {
+	t.Helper()
+	select {
+	case msg, ok := <-ch:
+		require.True(t, ok, "channel closed")
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+		return nil
+	}
+}
+
+func TestBroker_FilterByEventID(t *testing.T) {
+	b, _ := startBroker(t)
+	all, err := b.Subscribe()
+	require.NoError(t, err)
+	onlyB, err := b.SubscribeWithOptions(broker.WithEventIDs(eventB))
+	require.NoError(t, err)
+
+	require.NoError(t, b.Publish(context.Background(), newEvent(eventA, 1)))
+	require.NoError(t, b.Publish(context.Background(), newEvent(eventB, 2)))
+
+	require.Equal(t, 1, receive(t, all).Data())
+	require.Equal(t, 2, receive(t, all).Data())
+	require.Equal(t, 2, receive(t, onlyB).Data())
+}
+
+func TestBroker_DropPolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   broker.Policy
+		expected int
+	}{
+		{name: "DropOldest", policy: broker.PolicyDropOldest, expected: 3},
+		{name: "DropNewest", policy: broker.PolicyDropNewest, expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, sink := startBroker(t)
+			slow, err := b.SubscribeWithOptions(
+				broker.WithPolicy(tt.policy),
+				broker.WithBufferSize(1),
+			)
+			require.NoError(t, err)
+			// A second subscriber observes when all messages have been
+			// broadcast.
+			observer, err := b.Subscribe()
+			require.NoError(t, err)
+
+			for i := 1; i <= 3; i++ {
+				require.NoError(
+					t, b.Publish(context.Background(), newEvent(eventA, i)),
+				)
+				receive(t, observer)
+			}
+
+			require.Equal(t, tt.expected, receive(t, slow).Data())
+			require.Equal(t, int64(2), sink.count.Load())
+		})
+	}
+}
+
+func TestBroker_DisconnectPolicy(t *testing.T) {
+	b, sink := startBroker(t)
+	slow, err := b.SubscribeWithOptions(
+		broker.WithPolicy(broker.PolicyDisconnect),
+		broker.WithBufferSize(1),
+	)
+	require.NoError(t, err)
+	observer, err := b.Subscribe()
+	require.NoError(t, err)
+
+	for i := 1; i <= 2; i++ {
+		require.NoError(t, b.Publish(context.Background(), newEvent(eventA, i)))
+		receive(t, observer)
+	}
+
+	require.Equal(t, 1, receive(t, slow).Data())
+	select {
+	case _, ok := <-slow:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("slow subscriber was not disconnected")
+	}
+	require.Equal(t, int64(1), sink.count.Load())
+}
+
+func TestBroker_InvalidOptions(t *testing.T) {
+	b := broker.New[*testEvent]("test", &countingSink{})
+	_, err := b.SubscribeWithOptions(
+		broker.WithPolicy(broker.PolicyDropNewest),
+		broker.WithBufferSize(0),
+	)
+	require.ErrorIs(t, err, broker.ErrInvalidBufferSize)
+	_, err = b.SubscribeWithOptions(broker.WithPolicy(broker.Policy(42)))
+	require.ErrorIs(t, err, broker.ErrUnknownPolicy)
+	_, err = b.SubscribeWithOptions(broker.WithTimeout(-time.Second))
+	require.ErrorIs(t, err, broker.ErrInvalidTimeout)
+}
+
+func TestBroker_BlockPolicyTimeout(t *testing.T) {
+	b, sink := startBroker(t)
+	// A blocking subscriber that never reads only delays the others until
+	// its timeout expires.
+	_, err := b.SubscribeWithOptions(
+		broker.WithBufferSize(0),
+		broker.WithTimeout(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+	observer, err := b.Subscribe()
+	require.NoError(t, err)
+
+	for i := 1; i <= 2; i++ {
+		require.NoError(t, b.Publish(context.Background(), newEvent(eventA, i)))
+		require.Equal(t, i, receive(t, observer).Data())
+	}
+	// The observer may be served before the blocking subscriber times out.
+	require.Eventually(t, func() bool {
+		return sink.count.Load() == 2
+	}, time.Second, time.Millisecond)
+}
+
+func TestBroker_ConcurrentUnsubscribe(t *testing.T) {
+	b, _ := startBroker(t)
+	// A blocking subscriber that never reads must not prevent others from
+	// unsubscribing.
+	_, err := b.SubscribeWithOptions(
+		broker.WithBufferSize(0), broker.WithTimeout(0),
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ch, subErr := b.Subscribe()
+			require.NoError(t, subErr)
+			b.Unsubscribe(ch)
+			b.Unsubscribe(ch)
+			_, ok := <-ch
+			require.False(t, ok)
+		}()
+	}
+	require.NoError(t, b.Publish(context.Background(), newEvent(eventA, 1)))
+	wg.Wait()
+}
@@ -23,10 +23,13 @@ package broker
 import "time"
 
 const (
-	// defaultTimeout specifies the default timeout when the broker
-	// tries to send a message to a client, a message is published to the
-	// broker, or a client subscribes or unsubscribes.
-	defaultTimeout = time.Second
-	// defaultBufferSize specifies the default size of the message buffer.
+	// defaultBufferSize specifies the default size of the message buffer
+	// and of subscriber channels.
 	defaultBufferSize = 10
+	// defaultTimeout specifies the default time a blocking delivery waits
+	// for a subscriber before the message is dropped.
+	defaultTimeout = time.Second
+	// droppedMessagesMetric is the counter incremented for every message
+	// dropped by a subscriber's backpressure policy.
+	droppedMessagesMetric = "beacon_kit.async.broker.dropped_messages"
 )
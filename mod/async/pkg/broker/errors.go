@@ -20,9 +20,17 @@
 
 package broker
 
-import (
-	"errors"
-)
+import "errors"
 
-// ErrTimeout is the error returned when a broker operation timed out.
-var ErrTimeout = errors.New("timeout")
+var (
+	// ErrInvalidBufferSize is returned when subscribing with a policy that
+	// drops messages and no buffer to drop them from.
+	ErrInvalidBufferSize = errors.New(
+		"buffer size must be positive for non-blocking policies",
+	)
+	// ErrUnknownPolicy is returned when subscribing with an unknown policy.
+	ErrUnknownPolicy = errors.New("unknown backpressure policy")
+	// ErrInvalidTimeout is returned when subscribing with a negative
+	// timeout.
+	ErrInvalidTimeout = errors.New("timeout must not be negative")
+)
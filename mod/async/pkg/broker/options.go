@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// subscriptionConfig holds the settings of a single subscription.
+type subscriptionConfig struct {
+	// eventIDs is the set of event types delivered to the subscriber. An
+	// empty set delivers every event.
+	eventIDs map[types.EventID]struct{}
+	// policy is the backpressure policy of the subscriber.
+	policy Policy
+	// bufferSize is the capacity of the subscriber channel.
+	bufferSize int
+	// timeout is how long PolicyBlock waits for the subscriber before
+	// dropping the message. A zero timeout waits indefinitely.
+	timeout time.Duration
+}
+
+// SubscriptionOption configures a subscription.
+type SubscriptionOption func(*subscriptionConfig)
+
+// WithEventIDs restricts the subscription to events of the given types.
+func WithEventIDs(eventIDs ...types.EventID) SubscriptionOption {
+	return func(cfg *subscriptionConfig) {
+		for _, id := range eventIDs {
+			cfg.eventIDs[id] = struct{}{}
+		}
+	}
+}
+
+// WithPolicy sets the backpressure policy of the subscription.
+func WithPolicy(policy Policy) SubscriptionOption {
+	return func(cfg *subscriptionConfig) {
+		cfg.policy = policy
+	}
+}
+
+// WithBufferSize sets the capacity of the subscriber channel. Every policy
+// other than PolicyBlock requires a buffer of at least one message.
+func WithBufferSize(size int) SubscriptionOption {
+	return func(cfg *subscriptionConfig) {
+		cfg.bufferSize = size
+	}
+}
+
+// WithTimeout sets how long PolicyBlock waits for the subscriber before
+// dropping the message. A zero timeout blocks the broker until the message is
+// delivered, and should only be used by subscribers that always keep up, such
+// as the ones on the consensus path.
+func WithTimeout(timeout time.Duration) SubscriptionOption {
+	return func(cfg *subscriptionConfig) {
+		cfg.timeout = timeout
+	}
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// subscription is a single client of the broker.
+type subscription[T Event] struct {
+	subscriptionConfig
+	// ch is the channel the subscriber receives messages on.
+	ch chan T
+	// done is closed when the subscription is closed, waking up any
+	// delivery blocked on ch.
+	done chan struct{}
+	// mu serializes deliveries on ch with closing it.
+	mu sync.Mutex
+	// closed is true once ch has been closed.
+	closed bool
+	// closeOnce ensures the subscription is only closed once.
+	closeOnce sync.Once
+}
+
+// newSubscription creates a new subscription with the given config.
+func newSubscription[T Event](cfg subscriptionConfig) *subscription[T] {
+	return &subscription[T]{
+		subscriptionConfig: cfg,
+		ch:                 make(chan T, cfg.bufferSize),
+		done:               make(chan struct{}),
+	}
+}
+
+// accepts returns true if the subscriber registered for the type of msg.
+func (s *subscription[T]) accepts(msg T) bool {
+	if len(s.eventIDs) == 0 {
+		return true
+	}
+	_, ok := s.eventIDs[msg.Type()]
+	return ok
+}
+
+// deliver sends msg to the subscriber according to its backpressure policy.
+// It returns whether a message was dropped and whether the subscriber must
+// be disconnected.
+func (s *subscription[T]) deliver(
+	ctx context.Context, msg T,
+) (bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false, false
+	}
+
+	// Fast path, the subscriber has room for the message.
+	select {
+	case s.ch <- msg:
+		return false, false
+	default:
+	}
+
+	switch s.policy {
+	case PolicyBlock:
+		var timeout <-chan time.Time
+		if s.timeout > 0 {
+			timer := time.NewTimer(s.timeout)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+		select {
+		case s.ch <- msg:
+		case <-timeout:
+			return true, false
+		case <-s.done:
+		case <-ctx.Done():
+		}
+		return false, false
+	case PolicyDropOldest:
+		var dropped bool
+		select {
+		case <-s.ch:
+			dropped = true
+		default:
+		}
+		select {
+		case s.ch <- msg:
+		default:
+			dropped = true
+		}
+		return dropped, false
+	case PolicyDisconnect:
+		return true, true
+	case PolicyDropNewest:
+		fallthrough
+	default:
+		return true, false
+	}
+}
+
+// close closes the subscriber channel. It is safe to call close more than
+// once and concurrently with deliver.
+func (s *subscription[T]) close() {
+	s.closeOnce.Do(func() {
+		// Wake up a blocked delivery before waiting for it to release mu.
+		close(s.done)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.closed = true
+		close(s.ch)
+	})
+}
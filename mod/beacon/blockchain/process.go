@@ -72,9 +72,7 @@ func (s *Service[
 	// If the blobs needed to process the block are not available, we
 	// return an error. It is safe to use the slot off of the beacon block
 	// since it has been verified as correct already.
-	if !s.sb.AvailabilityStore().IsDataAvailable(
-		ctx, blk.GetSlot(), blk.GetBody(),
-	) {
+	if !s.isDataAvailable(ctx, blk) {
 		return nil, ErrDataNotAvailable
 	}
 
@@ -96,6 +94,33 @@ func (s *Service[
 	return valUpdates.RemoveDuplicates().Sort(), nil
 }
 
+// isDataAvailable returns true if all the blobs referenced in the block are
+// stored. Missing sidecars are reconstructed from the mempool of the execution
+// client before the data is declared unavailable.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _,
+]) isDataAvailable(
+	ctx context.Context,
+	blk BeaconBlockT,
+) bool {
+	avs := s.sb.AvailabilityStore()
+	if avs.IsDataAvailable(ctx, blk.GetSlot(), blk.GetBody()) {
+		return true
+	}
+
+	if err := s.sidecarsReconstructor.ReconstructSidecars(
+		ctx, blk,
+	); err != nil {
+		s.logger.Warn(
+			"Failed to reconstruct blob sidecars from the execution client",
+			"slot", blk.GetSlot().Base10(),
+			"err", err,
+		)
+		return false
+	}
+	return avs.IsDataAvailable(ctx, blk.GetSlot(), blk.GetBody())
+}
+
 // executeStateTransition runs the stf.
 func (s *Service[
 	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _,
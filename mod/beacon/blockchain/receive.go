@@ -27,6 +27,7 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // ReceiveBlock receives a block and blobs from the
@@ -76,10 +77,17 @@ func (s *Service[
 	// with the incoming block.
 	postState := preState.Copy()
 
-	// Verify the state root of the incoming block.
-	if err := s.verifyStateRoot(
-		ctx, postState, blk,
-	); err != nil {
+	// From Electra onwards, verify the eth1 data of the incoming block
+	// against the local deposit tree, as the state transition trusts it to
+	// prove the deposits of the block, and then verify its state root.
+	var err error
+	if s.cs.ActiveForkVersionForSlot(blk.GetSlot()) >= version.Electra {
+		err = s.dv.VerifyEth1Data(blk)
+	}
+	if err == nil {
+		err = s.verifyStateRoot(ctx, postState, blk)
+	}
+	if err != nil {
 		s.logger.Error(
 			"Rejecting incoming beacon block ❌ ",
 			"state_root",
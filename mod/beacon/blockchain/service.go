@@ -58,6 +58,12 @@ type Service[
 		BeaconBlockBodyT,
 		BeaconStateT,
 	]
+	// sidecarsReconstructor reconstructs the blob sidecars of a block from
+	// the mempool of the execution client when they are missing.
+	sidecarsReconstructor BlobSidecarsReconstructor[BeaconBlockT]
+	// dv verifies the eth1 data of incoming blocks against the local deposit
+	// tree.
+	dv DepositVerifier[BeaconBlockT]
 	// logger is used for logging messages in the service.
 	logger log.Logger[any]
 	// cs holds the chain specifications.
@@ -116,6 +122,8 @@ func NewService[
 		BeaconBlockBodyT,
 		BeaconStateT,
 	],
+	sidecarsReconstructor BlobSidecarsReconstructor[BeaconBlockT],
+	dv DepositVerifier[BeaconBlockT],
 	logger log.Logger[any],
 	cs common.ChainSpec,
 	ee ExecutionEngine[PayloadAttributesT],
@@ -144,6 +152,8 @@ func NewService[
 		GenesisT, PayloadAttributesT, WithdrawalT,
 	]{
 		sb:                      sb,
+		sidecarsReconstructor:   sidecarsReconstructor,
+		dv:                      dv,
 		logger:                  logger,
 		cs:                      cs,
 		ee:                      ee,
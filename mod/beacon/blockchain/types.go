@@ -42,6 +42,23 @@ type AvailabilityStore[BeaconBlockBodyT any] interface {
 	) bool
 }
 
+// BlobSidecarsReconstructor reconstructs the blob sidecars of a block whose
+// sidecars were not received.
+type BlobSidecarsReconstructor[BeaconBlockT any] interface {
+	// ReconstructSidecars rebuilds and stores the missing sidecars of the
+	// block.
+	ReconstructSidecars(context.Context, BeaconBlockT) error
+}
+
+// DepositVerifier verifies the eth1 data of incoming blocks against the local
+// deposit tree.
+type DepositVerifier[BeaconBlockT any] interface {
+	// VerifyEth1Data verifies that the eth1 data of the block matches the
+	// local deposit tree at the execution block it refers to, if that block
+	// is known locally.
+	VerifyEth1Data(BeaconBlockT) error
+}
+
 // BeaconBlock represents a beacon block interface.
 type BeaconBlock[
 	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
@@ -38,7 +38,7 @@ import (
 // buildBlockAndSidecars builds a new beacon block.
 func (s *Service[
 	AttestationDataT, BeaconBlockT, _, _,
-	BlobSidecarsT, _, _, _, _, _, _, SlashingInfoT, SlotDataT,
+	BlobSidecarsT, _, _, _, _, _, _, SlashingInfoT, SlotDataT, _,
 ]) buildBlockAndSidecars(
 	ctx context.Context,
 	slotData SlotDataT,
@@ -134,7 +134,7 @@ func (s *Service[
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _,
 ]) getEmptyBeaconBlockForSlot(
 	st BeaconStateT, requestedSlot math.Slot,
 ) (BeaconBlockT, error) {
@@ -172,7 +172,7 @@ func (s *Service[
 
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _, _,
 ]) buildRandaoReveal(
 	st BeaconStateT,
 	slot math.Slot,
@@ -201,7 +201,7 @@ func (s *Service[
 // retrieveExecutionPayload retrieves the execution payload for the block.
 func (s *Service[
 	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
-	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _, _,
 ]) retrieveExecutionPayload(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
@@ -258,7 +258,7 @@ func (s *Service[
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
 	AttestationDataT, BeaconBlockT, _, BeaconStateT, _,
-	_, _, Eth1DataT, ExecutionPayloadT, _, _, SlashingInfoT, SlotDataT,
+	_, _, Eth1DataT, ExecutionPayloadT, _, _, SlashingInfoT, SlotDataT, _,
 ]) buildBlockBody(
 	_ context.Context,
 	st BeaconStateT,
@@ -290,50 +290,107 @@ func (s *Service[
 		return ErrNilDepositIndexStart
 	}
 
-	// Dequeue deposits from the state.
-	deposits, err := s.bsb.DepositStore().GetDepositsByIndex(
-		depositIndex,
-		s.chainSpec.MaxDepositsPerBlock(),
+	// The latest execution payload header is the one of the parent block
+	// while building.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+
+	// Get the pending deposits along with their proofs against the eth1
+	// data of the local deposit tree, following the execution payload of the
+	// block by the eth1 follow distance.
+	deposits, proofs, eth1Data, err := s.depositProvider.GetDepositsWithProofs(
+		depositIndex, s.chainSpec.MaxDepositsPerBlock(), lph.GetNumber()+1,
 	)
 	if err != nil {
 		return err
 	}
 
-	// Set the deposits on the block body.
-	body.SetDeposits(deposits)
+	stateEth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
 
-	var eth1Data Eth1DataT
-	// TODO: assemble real eth1data.
-	body.SetEth1Data(eth1Data.New(
-		common.Root{},
-		0,
-		common.ExecutionHash{},
-	))
+	// The eth1 data of a block must not decrease the deposit count, hence
+	// a block cannot be built while the local deposit tree is behind.
+	if eth1Data.GetDepositCount() < stateEth1Data.GetDepositCount() {
+		return errors.Wrapf(
+			ErrDepositTreeBehind,
+			"local deposit count %d, state deposit count %d",
+			eth1Data.GetDepositCount(),
+			stateEth1Data.GetDepositCount(),
+		)
+	}
+
+	// Set the eth1 data and the deposits on the block body.
+	body.SetEth1Data(eth1Data)
+	body.SetDeposits(deposits)
 
 	// Set the graffiti on the block body.
 	body.SetGraffiti(bytes.ToBytes32([]byte(s.cfg.Graffiti)))
 
-	// Get the epoch to find the active fork version.
-	epoch := s.chainSpec.SlotToEpoch(blk.GetSlot())
-	activeForkVersion := s.chainSpec.ActiveForkVersionForEpoch(
-		epoch,
-	)
-	if activeForkVersion >= version.DenebPlus {
-		// Set the attestations on the block body.
-		body.SetAttestations(slotData.GetAttestationData())
+	body.SetExecutionPayload(envelope.GetExecutionPayload())
 
-		// Set the slashing info on the block body.
-		body.SetSlashingInfo(slotData.GetSlashingInfo())
+	// The remaining fields are only part of the block body from the Electra
+	// fork onwards.
+	if s.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()) < version.Electra {
+		return nil
 	}
 
-	body.SetExecutionPayload(envelope.GetExecutionPayload())
-	return nil
+	// Set the voluntary exits on the block body.
+	body.SetVoluntaryExits(s.getVoluntaryExits(st))
+
+	// Set the attestations on the block body.
+	body.SetAttestations(slotData.GetAttestationData())
+
+	// Set the slashing info on the block body.
+	body.SetSlashingInfo(slotData.GetSlashingInfo())
+
+	// Set the Merkle branches of the deposits on the block body.
+	body.SetDepositBranches(proofs)
+
+	// Set the execution requests triggered by the execution payload on the
+	// block body.
+	return body.SetExecutionRequestsList(envelope.GetExecutionRequests())
+}
+
+// getVoluntaryExits returns the pending voluntary exits that are valid
+// against the given state, up to the per block limit. Exits that are no
+// longer valid, such as those already included in a block, are dropped from
+// the pool.
+func (s *Service[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, VoluntaryExitT,
+]) getVoluntaryExits(st BeaconStateT) []VoluntaryExitT {
+	var (
+		exits []VoluntaryExitT
+		stale []math.ValidatorIndex
+	)
+	for _, exit := range s.exitPool.Pending() {
+		if uint64(len(exits)) == s.chainSpec.MaxVoluntaryExitsPerBlock() {
+			break
+		}
+		if err := s.stateProcessor.ValidateVoluntaryExit(
+			st, exit,
+		); err != nil {
+			s.logger.Warn(
+				"Dropping invalid voluntary exit from the pool",
+				"validator_index", exit.GetValidatorIndex().Base10(),
+				"error", err,
+			)
+			stale = append(stale, exit.GetValidatorIndex())
+			continue
+		}
+		exits = append(exits, exit)
+	}
+	s.exitPool.Remove(stale...)
+	return exits
 }
 
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _,
 ]) computeAndSetStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -354,7 +411,7 @@ func (s *Service[
 
 // computeStateRoot computes the state root of an outgoing block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _,
 ]) computeStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
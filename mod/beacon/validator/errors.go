@@ -37,4 +37,8 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrDepositTreeBehind is an error for when the local deposit tree holds
+	// fewer deposits than the eth1 data of the state.
+	ErrDepositTreeBehind = errors.New("deposit tree behind eth1 data")
 )
@@ -36,12 +36,13 @@ type Service[
 	AttestationDataT any,
 	BeaconBlockT BeaconBlock[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, DepositT,
-		Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		Eth1DataT, ExecutionPayloadT, SlashingInfoT, VoluntaryExitT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+		SlashingInfoT, VoluntaryExitT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
 	BlobSidecarsT,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
@@ -51,6 +52,7 @@ type Service[
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	VoluntaryExitT VoluntaryExit,
 ] struct {
 	// cfg is the validator config.
 	cfg *Config
@@ -63,19 +65,26 @@ type Service[
 	// blobFactory is used to create blob sidecars for blocks.
 	blobFactory BlobFactory[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
-		DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT, VoluntaryExitT,
 	]
 	// bsb is the beacon state backend.
 	bsb StorageBackend[
-		BeaconStateT, DepositT, DepositStoreT, ExecutionPayloadHeaderT,
+		BeaconStateT, DepositT, DepositStoreT, Eth1DataT,
+		ExecutionPayloadHeaderT,
 	]
+	// depositProvider provides the deposits to include in blocks.
+	depositProvider DepositProvider[DepositT, Eth1DataT]
 	// stateProcessor is responsible for processing the state.
 	stateProcessor StateProcessor[
 		BeaconBlockT,
 		BeaconStateT,
 		*transition.Context,
+		Eth1DataT,
 		ExecutionPayloadHeaderT,
+		VoluntaryExitT,
 	]
+	// exitPool holds the voluntary exits waiting to be included in a block.
+	exitPool VoluntaryExitPool[VoluntaryExitT]
 	// localPayloadBuilder represents the local block builder, this builder
 	// is connected to this nodes execution client via the EngineAPI.
 	// Building blocks are done by submitting forkchoice updates through.
@@ -99,12 +108,13 @@ func NewService[
 	AttestationDataT any,
 	BeaconBlockT BeaconBlock[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, DepositT,
-		Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		Eth1DataT, ExecutionPayloadT, SlashingInfoT, VoluntaryExitT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+		SlashingInfoT, VoluntaryExitT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
 	BlobSidecarsT,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
@@ -114,24 +124,30 @@ func NewService[
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	VoluntaryExitT VoluntaryExit,
 ](
 	cfg *Config,
 	logger log.Logger[any],
 	chainSpec common.ChainSpec,
 	bsb StorageBackend[
-		BeaconStateT, DepositT, DepositStoreT, ExecutionPayloadHeaderT,
+		BeaconStateT, DepositT, DepositStoreT, Eth1DataT,
+		ExecutionPayloadHeaderT,
 	],
+	depositProvider DepositProvider[DepositT, Eth1DataT],
 	stateProcessor StateProcessor[
 		BeaconBlockT,
 		BeaconStateT,
 		*transition.Context,
+		Eth1DataT,
 		ExecutionPayloadHeaderT,
+		VoluntaryExitT,
 	],
 	signer crypto.BLSSigner,
 	blobFactory BlobFactory[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
-		DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT, VoluntaryExitT,
 	],
+	exitPool VoluntaryExitPool[VoluntaryExitT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	ts TelemetrySink,
@@ -142,19 +158,23 @@ func NewService[
 	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 	BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
 	ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+	VoluntaryExitT,
 ] {
 	return &Service[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 		BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+		VoluntaryExitT,
 	]{
 		cfg:                   cfg,
 		logger:                logger,
 		bsb:                   bsb,
+		depositProvider:       depositProvider,
 		chainSpec:             chainSpec,
 		signer:                signer,
 		stateProcessor:        stateProcessor,
 		blobFactory:           blobFactory,
+		exitPool:              exitPool,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
 		metrics:               newValidatorMetrics(ts),
@@ -166,14 +186,14 @@ func NewService[
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "validator"
 }
 
 // Start starts the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(
 	ctx context.Context,
 ) error {
@@ -183,7 +203,7 @@ func (s *Service[
 
 // start starts the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) start(
 	ctx context.Context,
 ) {
@@ -201,7 +221,7 @@ func (s *Service[
 
 // handleBlockRequest handles a block request.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, SlotDataT,
+	_, _, _, _, _, _, _, _, _, _, _, _, SlotDataT, _,
 ]) handleNewSlot(msg *asynctypes.Event[SlotDataT]) {
 	blk, sidecars, err := s.buildBlockAndSidecars(
 		msg.Context(), msg.Data(),
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -38,12 +39,14 @@ type BeaconBlock[
 	AttestationDataT any,
 	BeaconBlockT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+		SlashingInfoT, VoluntaryExitT,
 	],
 	DepositT,
 	Eth1DataT,
 	ExecutionPayloadT,
-	SlashingInfoT any,
+	SlashingInfoT,
+	VoluntaryExitT any,
 ] interface {
 	constraints.SSZMarshallable
 	// NewWithVersion creates a new beacon block with the given parameters.
@@ -67,7 +70,8 @@ type BeaconBlock[
 
 // BeaconBlockBody represents a beacon block body interface.
 type BeaconBlockBody[
-	AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT any,
+	AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+	SlashingInfoT, VoluntaryExitT any,
 ] interface {
 	constraints.SSZMarshallable
 	constraints.Nillable
@@ -77,6 +81,8 @@ type BeaconBlockBody[
 	SetEth1Data(Eth1DataT)
 	// SetDeposits sets the deposits of the beacon block body.
 	SetDeposits([]DepositT)
+	// SetDepositBranches sets the Merkle branches of the deposits.
+	SetDepositBranches([][]common.Root)
 	// SetExecutionPayload sets the execution data of the beacon block body.
 	SetExecutionPayload(ExecutionPayloadT)
 	// SetGraffiti sets the graffiti of the beacon block body.
@@ -88,12 +94,19 @@ type BeaconBlockBody[
 	// SetBlobKzgCommitments sets the blob KZG commitments of the beacon block
 	// body.
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+	// SetVoluntaryExits sets the voluntary exits of the beacon block body.
+	SetVoluntaryExits([]VoluntaryExitT)
+	// SetExecutionRequestsList sets the execution requests of the beacon
+	// block body from the list of typed requests of EIP-7685.
+	SetExecutionRequestsList([]bytes.Bytes) error
 }
 
 // BeaconState represents a beacon state interface.
-type BeaconState[ExecutionPayloadHeaderT any] interface {
+type BeaconState[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	// GetBlockRootAtIndex returns the block root at the given index.
 	GetBlockRootAtIndex(uint64) (common.Root, error)
+	// GetEth1Data returns the eth1 data of the beacon state.
+	GetEth1Data() (Eth1DataT, error)
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
 	// header.
 	GetLatestExecutionPayloadHeader() (
@@ -117,16 +130,18 @@ type BlobFactory[
 	AttestationDataT any,
 	BeaconBlockT BeaconBlock[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, DepositT,
-		Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		Eth1DataT, ExecutionPayloadT, SlashingInfoT, VoluntaryExitT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+		SlashingInfoT, VoluntaryExitT,
 	],
 	BlobSidecarsT,
 	DepositT,
 	Eth1DataT,
 	ExecutionPayloadT,
-	SlashingInfoT any,
+	SlashingInfoT,
+	VoluntaryExitT any,
 ] interface {
 	// BuildSidecars builds sidecars for a given block and blobs bundle.
 	BuildSidecars(
@@ -144,6 +159,20 @@ type DepositStore[DepositT any] interface {
 	) ([]DepositT, error)
 }
 
+// DepositProvider provides the pending deposits along with their Merkle
+// branches against the deposit root.
+type DepositProvider[DepositT, Eth1DataT any] interface {
+	// GetDepositsWithProofs returns up to `numView` deposits starting at the
+	// given index, along with their Merkle branches and the eth1 data they
+	// are proven against. The eth1 data refers to an execution block at
+	// least the eth1 follow distance behind the given execution block.
+	GetDepositsWithProofs(
+		startIndex uint64,
+		numView uint64,
+		blockNumber math.U64,
+	) ([]DepositT, [][]common.Root, Eth1DataT, error)
+}
+
 // Eth1Data represents the eth1 data interface.
 type Eth1Data[T any] interface {
 	// New creates a new eth1 data with the given parameters.
@@ -152,6 +181,8 @@ type Eth1Data[T any] interface {
 		depositCount math.U64,
 		blockHash common.ExecutionHash,
 	) T
+	// GetDepositCount returns the number of deposits in the deposit tree.
+	GetDepositCount() math.U64
 }
 
 // ExecutionPayloadHeader represents the execution payload header interface.
@@ -162,6 +193,8 @@ type ExecutionPayloadHeader interface {
 	GetBlockHash() common.ExecutionHash
 	// GetParentHash returns the parent hash of the execution payload header.
 	GetParentHash() common.ExecutionHash
+	// GetNumber returns the block number of the execution payload header.
+	GetNumber() math.U64
 }
 
 // EventSubscription represents the event subscription interface.
@@ -222,9 +255,11 @@ type SlotData[AttestationDataT, SlashingInfoT any] interface {
 // StateProcessor defines the interface for processing the state.
 type StateProcessor[
 	BeaconBlockT any,
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
 	ContextT,
-	ExecutionPayloadHeaderT any,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	VoluntaryExitT any,
 ] interface {
 	// ProcessSlot processes the slot.
 	ProcessSlots(
@@ -236,13 +271,17 @@ type StateProcessor[
 		st BeaconStateT,
 		blk BeaconBlockT,
 	) (transition.ValidatorUpdates, error)
+	// ValidateVoluntaryExit checks that the voluntary exit may be applied to
+	// the given state.
+	ValidateVoluntaryExit(st BeaconStateT, exit VoluntaryExitT) error
 }
 
 // StorageBackend is the interface for the storage backend.
 type StorageBackend[
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
+	Eth1DataT any,
 	ExecutionPayloadHeaderT any,
 ] interface {
 	// DepositStore retrieves the deposit store.
@@ -251,6 +290,21 @@ type StorageBackend[
 	StateFromContext(context.Context) BeaconStateT
 }
 
+// VoluntaryExit represents a signed voluntary exit interface.
+type VoluntaryExit interface {
+	// GetValidatorIndex returns the index of the exiting validator.
+	GetValidatorIndex() math.ValidatorIndex
+}
+
+// VoluntaryExitPool defines the interface for the pool of voluntary exits
+// waiting to be included in a block.
+type VoluntaryExitPool[VoluntaryExitT VoluntaryExit] interface {
+	// Pending returns the pending exits.
+	Pending() []VoluntaryExitT
+	// Remove drops the exits of the given validators from the pool.
+	Remove(indices ...math.ValidatorIndex)
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
@@ -57,6 +57,18 @@ type Spec[
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
 
+	// MaxSeedLookahead returns the minimum number of epochs between an exit
+	// being initiated and taking effect.
+	MaxSeedLookahead() uint64
+
+	// MinValidatorWithdrawabilityDelay returns the number of epochs between a
+	// validator exiting and its balance becoming withdrawable.
+	MinValidatorWithdrawabilityDelay() uint64
+
+	// ShardCommitteePeriod returns the number of epochs a validator must be
+	// active before it may voluntarily exit.
+	ShardCommitteePeriod() uint64
+
 	// Signature Domains
 
 	// DomainTypeProposer returns the domain for proposer signatures.
@@ -102,6 +114,12 @@ type Spec[
 	// TargetSecondsPerEth1Block returns the target time between eth1 blocks.
 	TargetSecondsPerEth1Block() uint64
 
+	// Max operations per block.
+
+	// MaxVoluntaryExitsPerBlock returns the maximum number of voluntary exits
+	// per block.
+	MaxVoluntaryExitsPerBlock() uint64
+
 	// Fork-related values.
 	// DenebPlusForkEpoch returns the epoch at which the Deneb+ fork takes
 	DenebPlusForkEpoch() EpochT
@@ -127,6 +145,10 @@ type Spec[
 
 	// Rewards and Penalties
 
+	// BaseRewardFactor returns the factor used to derive the base reward of
+	// a validator from its effective balance.
+	BaseRewardFactor() uint64
+
 	// InactivityPenaltyQuotient returns the inactivity penalty quotient.
 	InactivityPenaltyQuotient() uint64
 
@@ -134,6 +156,26 @@ type Spec[
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
 
+	// MinSlashingPenaltyQuotient returns the divisor applied to the
+	// effective balance of a validator to compute its initial slashing
+	// penalty.
+	MinSlashingPenaltyQuotient() uint64
+
+	// WhistleblowerRewardQuotient returns the divisor applied to the
+	// effective balance of a slashed validator to compute the whistleblower
+	// reward.
+	WhistleblowerRewardQuotient() uint64
+
+	// Validator cycle.
+
+	// MinPerEpochChurnLimit returns the minimum number of validators at the
+	// maximum effective balance allowed to activate or exit per epoch.
+	MinPerEpochChurnLimit() uint64
+
+	// ChurnLimitQuotient returns the divisor applied to the total active
+	// balance to compute the per epoch churn limit.
+	ChurnLimitQuotient() uint64
+
 	// Capella Values
 
 	// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
@@ -267,6 +309,30 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// MaxSeedLookahead returns the minimum number of epochs between an exit
+// being initiated and taking effect.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxSeedLookahead() uint64 {
+	return c.Data.MaxSeedLookahead
+}
+
+// MinValidatorWithdrawabilityDelay returns the number of epochs between a
+// validator exiting and its balance becoming withdrawable.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinValidatorWithdrawabilityDelay() uint64 {
+	return c.Data.MinValidatorWithdrawabilityDelay
+}
+
+// ShardCommitteePeriod returns the number of epochs a validator must be
+// active before it may voluntarily exit.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ShardCommitteePeriod() uint64 {
+	return c.Data.ShardCommitteePeriod
+}
+
 // DomainTypeProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -360,6 +426,14 @@ func (c chainSpec[
 	return c.Data.TargetSecondsPerEth1Block
 }
 
+// MaxVoluntaryExitsPerBlock returns the maximum number of voluntary exits
+// per block.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxVoluntaryExitsPerBlock() uint64 {
+	return c.Data.MaxVoluntaryExitsPerBlock
+}
+
 // DenebPlusForEpoch returns the epoch of the Deneb+ fork.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -402,6 +476,13 @@ func (c chainSpec[
 	return c.Data.ValidatorRegistryLimit
 }
 
+// BaseRewardFactor returns the base reward factor.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) BaseRewardFactor() uint64 {
+	return c.Data.BaseRewardFactor
+}
+
 // InactivityPenaltyQuotient returns the inactivity penalty quotient.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -416,6 +497,36 @@ func (c chainSpec[
 	return c.Data.ProportionalSlashingMultiplier
 }
 
+// MinSlashingPenaltyQuotient returns the minimum slashing penalty quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinSlashingPenaltyQuotient() uint64 {
+	return c.Data.MinSlashingPenaltyQuotient
+}
+
+// WhistleblowerRewardQuotient returns the whistleblower reward quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) WhistleblowerRewardQuotient() uint64 {
+	return c.Data.WhistleblowerRewardQuotient
+}
+
+// MinPerEpochChurnLimit returns the minimum number of validators at the
+// maximum effective balance allowed to activate or exit per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// ChurnLimitQuotient returns the divisor applied to the total active balance
+// to compute the per epoch churn limit.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (c chainSpec[
@@ -52,6 +52,15 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// MaxSeedLookahead is the number of epochs between an exit being
+	// initiated and the exit taking effect, at the earliest.
+	MaxSeedLookahead uint64 `mapstructure:"max-seed-lookahead"`
+	// MinValidatorWithdrawabilityDelay is the number of epochs between a
+	// validator exiting and its balance becoming withdrawable.
+	MinValidatorWithdrawabilityDelay uint64 `mapstructure:"min-validator-withdrawability-delay"`
+	// ShardCommitteePeriod is the number of epochs a validator must be
+	// active before it is allowed to voluntarily exit.
+	ShardCommitteePeriod uint64 `mapstructure:"shard-committee-period"`
 
 	// Signature domains.
 	//
@@ -89,6 +98,12 @@ type SpecData[
 	// TargetSecondsPerEth1Block is the target time between eth1 blocks.
 	TargetSecondsPerEth1Block uint64 `mapstructure:"target-seconds-per-eth1-block"`
 
+	// Max operations per block.
+	//
+	// MaxVoluntaryExitsPerBlock specifies the maximum number of voluntary
+	// exits allowed per block.
+	MaxVoluntaryExitsPerBlock uint64 `mapstructure:"max-voluntary-exits-per-block"`
+
 	// Fork-related values.
 	//
 	// DenebPlus is the epoch at which the Deneb+ fork is activated.
@@ -111,11 +126,29 @@ type SpecData[
 
 	// Rewards and penalties constants.
 	//
+	// BaseRewardFactor is the factor used to derive the base reward of a
+	// validator from its effective balance.
+	BaseRewardFactor uint64 `mapstructure:"base-reward-factor"`
 	// InactivityPenaltyQuotient is the inactivity penalty quotient.
 	InactivityPenaltyQuotient uint64 `mapstructure:"inactivity-penalty-quotient"`
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
+	// MinSlashingPenaltyQuotient is the divisor applied to the effective
+	// balance of a validator to compute its initial slashing penalty.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
+	// WhistleblowerRewardQuotient is the divisor applied to the effective
+	// balance of a slashed validator to compute the whistleblower reward.
+	WhistleblowerRewardQuotient uint64 `mapstructure:"whistleblower-reward-quotient"`
+
+	// Validator cycle.
+	//
+	// MinPerEpochChurnLimit is the minimum number of validators at the
+	// maximum effective balance allowed to activate or exit per epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// ChurnLimitQuotient is the divisor applied to the total active balance
+	// to compute the per epoch churn limit.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
 
 	// Capella Values
 	//
@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// bytesPerFieldElement is the number of bytes in a single field element of
+// an EIP-4844 blob.
+const bytesPerFieldElement = 32
+
+var (
+	// ErrZeroValue is returned when a field that must be positive is zero.
+	ErrZeroValue = errors.New("must be greater than zero")
+	// ErrNotPowerOfTwo is returned when a field that must be a power of two
+	// is not.
+	ErrNotPowerOfTwo = errors.New("must be a power of two")
+	// ErrInconsistentSpec is returned when two or more fields contradict
+	// each other.
+	ErrInconsistentSpec = errors.New("inconsistent chain spec")
+)
+
+// Validate checks every field of the spec data, returning the first error
+// encountered.
+func (d SpecData[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) Validate() error {
+	for _, validate := range []func() error{
+		d.validateGwei,
+		d.validateTime,
+		d.validateDomains,
+		d.validateEth1,
+		d.validateForks,
+		d.validateStateLists,
+		d.validateRewardsAndPenalties,
+		d.validateValidatorCycle,
+		d.validateWithdrawals,
+		d.validateBlobs,
+	} {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateGwei validates the gwei value constants.
+func (d SpecData[_, _, _, _, _]) validateGwei() error {
+	if err := positive(
+		field{"min-deposit-amount", d.MinDepositAmount},
+		field{"max-effective-balance", d.MaxEffectiveBalance},
+		field{"ejection-balance", d.EjectionBalance},
+		field{"effective-balance-increment", d.EffectiveBalanceIncrement},
+	); err != nil {
+		return err
+	}
+
+	switch {
+	case d.MaxEffectiveBalance%d.EffectiveBalanceIncrement != 0:
+		return inconsistent(
+			"max-effective-balance must be a multiple of " +
+				"effective-balance-increment",
+		)
+	case d.EjectionBalance >= d.MaxEffectiveBalance:
+		return inconsistent(
+			"ejection-balance must be less than max-effective-balance",
+		)
+	case d.MinDepositAmount > d.MaxEffectiveBalance:
+		return inconsistent(
+			"min-deposit-amount must not exceed max-effective-balance",
+		)
+	}
+	return nil
+}
+
+// validateTime validates the time parameters.
+func (d SpecData[_, _, _, _, _]) validateTime() error {
+	if err := positive(
+		field{"slots-per-epoch", d.SlotsPerEpoch},
+		field{"min-epochs-to-inactivity-penalty", d.MinEpochsToInactivityPenalty},
+	); err != nil {
+		return err
+	}
+	return powerOfTwo(
+		field{"slots-per-historical-root", d.SlotsPerHistoricalRoot},
+	)
+}
+
+// validateDomains validates that the signature domains are distinct, so
+// that a signature over one type of message can never be replayed as
+// another.
+func (d SpecData[DomainTypeT, _, _, _, _]) validateDomains() error {
+	domains := []struct {
+		name   string
+		domain DomainTypeT
+	}{
+		{"domain-type-beacon-proposer", d.DomainTypeProposer},
+		{"domain-type-beacon-attester", d.DomainTypeAttester},
+		{"domain-type-randao", d.DomainTypeRandao},
+		{"domain-type-deposit", d.DomainTypeDeposit},
+		{"domain-type-voluntary-exit", d.DomainTypeVoluntaryExit},
+		{"domain-type-selection-proof", d.DomainTypeSelectionProof},
+		{"domain-type-aggregate-and-proof", d.DomainTypeAggregateAndProof},
+		{"domain-type-application-mask", d.DomainTypeApplicationMask},
+	}
+	seen := make(map[DomainTypeT]string, len(domains))
+	for _, domain := range domains {
+		if other, ok := seen[domain.domain]; ok {
+			return inconsistent(fmt.Sprintf(
+				"%s must differ from %s", domain.name, other,
+			))
+		}
+		seen[domain.domain] = domain.name
+	}
+	return nil
+}
+
+// validateEth1 validates the eth1-related values.
+func (d SpecData[_, _, ExecutionAddressT, _, _]) validateEth1() error {
+	var zeroAddress ExecutionAddressT
+	if d.DepositContractAddress == zeroAddress {
+		return fmt.Errorf("deposit-contract-address: %w", ErrZeroValue)
+	}
+	return positive(
+		field{"max-deposits-per-block", d.MaxDepositsPerBlock},
+		field{"deposit-eth1-chain-id", d.DepositEth1ChainID},
+		field{"target-seconds-per-eth1-block", d.TargetSecondsPerEth1Block},
+		field{"max-voluntary-exits-per-block", d.MaxVoluntaryExitsPerBlock},
+	)
+}
+
+// validateForks validates that the forks are scheduled in order.
+func (d SpecData[_, _, _, _, _]) validateForks() error {
+	if d.DenebPlusForkEpoch > d.ElectraForkEpoch {
+		return inconsistent(
+			"deneb-plus-fork-epoch must not be after electra-fork-epoch",
+		)
+	}
+	return nil
+}
+
+// validateStateLists validates the state list lengths, which must be
+// powers of two to be merkleized.
+func (d SpecData[_, _, _, _, _]) validateStateLists() error {
+	return powerOfTwo(
+		field{"epochs-per-historical-vector", d.EpochsPerHistoricalVector},
+		field{"epochs-per-slashings-vector", d.EpochsPerSlashingsVector},
+		field{"historical-roots-limit", d.HistoricalRootsLimit},
+		field{"validator-registry-limit", d.ValidatorRegistryLimit},
+	)
+}
+
+// validateRewardsAndPenalties validates the rewards and penalties
+// constants.
+func (d SpecData[_, _, _, _, _]) validateRewardsAndPenalties() error {
+	return positive(
+		field{"base-reward-factor", d.BaseRewardFactor},
+		field{"inactivity-penalty-quotient", d.InactivityPenaltyQuotient},
+		field{"proportional-slashing-multiplier", d.ProportionalSlashingMultiplier},
+		field{"min-slashing-penalty-quotient", d.MinSlashingPenaltyQuotient},
+		field{"whistleblower-reward-quotient", d.WhistleblowerRewardQuotient},
+	)
+}
+
+// validateValidatorCycle validates the churn limit constants.
+func (d SpecData[_, _, _, _, _]) validateValidatorCycle() error {
+	return positive(
+		field{"min-per-epoch-churn-limit", d.MinPerEpochChurnLimit},
+		field{"churn-limit-quotient", d.ChurnLimitQuotient},
+	)
+}
+
+// validateWithdrawals validates the Capella values.
+func (d SpecData[_, _, _, _, _]) validateWithdrawals() error {
+	return positive(
+		field{"max-withdrawals-per-payload", d.MaxWithdrawalsPerPayload},
+		field{
+			"max-validators-per-withdrawals-sweep",
+			d.MaxValidatorsPerWithdrawalsSweep,
+		},
+	)
+}
+
+// validateBlobs validates that the Deneb values are consistent with each
+// other.
+func (d SpecData[_, _, _, _, _]) validateBlobs() error {
+	if err := positive(
+		field{
+			"min-epochs-for-blobs-sidecars-request",
+			d.MinEpochsForBlobsSidecarsRequest,
+		},
+		field{"max-blobs-per-block", d.MaxBlobsPerBlock},
+		field{
+			"kzg-commitment-inclusion-proof-depth",
+			d.KZGCommitmentInclusionProofDepth,
+		},
+	); err != nil {
+		return err
+	}
+	if err := powerOfTwo(
+		field{"max-blob-commitments-per-block", d.MaxBlobCommitmentsPerBlock},
+		field{"field-elements-per-blob", d.FieldElementsPerBlob},
+	); err != nil {
+		return err
+	}
+
+	switch {
+	case d.MaxBlobsPerBlock > d.MaxBlobCommitmentsPerBlock:
+		return inconsistent(
+			"max-blobs-per-block must not exceed " +
+				"max-blob-commitments-per-block",
+		)
+	case d.BytesPerBlob != d.FieldElementsPerBlob*bytesPerFieldElement:
+		return inconsistent(fmt.Sprintf(
+			"bytes-per-blob must equal %d * field-elements-per-blob",
+			bytesPerFieldElement,
+		))
+	}
+	return nil
+}
+
+// field is a named numeric field of the spec data.
+type field struct {
+	name  string
+	value uint64
+}
+
+// positive returns an error for the first of the given fields that is zero.
+func positive(fields ...field) error {
+	for _, f := range fields {
+		if f.value == 0 {
+			return fmt.Errorf("%s: %w", f.name, ErrZeroValue)
+		}
+	}
+	return nil
+}
+
+// powerOfTwo returns an error for the first of the given fields that is not
+// a power of two.
+func powerOfTwo(fields ...field) error {
+	for _, f := range fields {
+		if f.value == 0 || f.value&(f.value-1) != 0 {
+			return fmt.Errorf("%s: %w", f.name, ErrNotPowerOfTwo)
+		}
+	}
+	return nil
+}
+
+// inconsistent wraps ErrInconsistentSpec with the given reason.
+func inconsistent(reason string) error {
+	return fmt.Errorf("%w: %s", ErrInconsistentSpec, reason)
+}
@@ -21,12 +21,14 @@
 package builder
 
 import (
+	"io"
 	"os"
 
 	"cosmossdk.io/depinject"
 	sdklog "cosmossdk.io/log"
 	cmdlib "github.com/berachain/beacon-kit/mod/cli/pkg/commands"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/config"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
@@ -38,6 +40,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
@@ -89,6 +92,10 @@ func (cb *CLIBuilder[T, ExecutionPayloadT]) Build() (*cmdlib.Root, error) {
 		chainSpec common.ChainSpec
 		logger    log.AdvancedLogger[any, sdklog.Logger]
 	)
+	// the commands are built with the chain spec before the command line
+	// is parsed, so the chain spec flag is looked up ahead of time
+	setChainSpecFromArgs(os.Args[1:])
+
 	// build dependencies for the root command
 	//nolint:asasalint // todo fix.
 	if err := depinject.Inject(
@@ -127,6 +134,20 @@ func (cb *CLIBuilder[T, ExecutionPayloadT]) Build() (*cmdlib.Root, error) {
 	return rootCmd, nil
 }
 
+// setChainSpecFromArgs sets the chain spec given on the command line, if
+// any, on the global viper instance the chain spec is provided from.
+func setChainSpecFromArgs(args []string) {
+	fs := pflag.NewFlagSet(flags.ChainSpec, pflag.ContinueOnError)
+	fs.ParseErrorsWhitelist.UnknownFlags = true
+	fs.SetOutput(io.Discard)
+	chainSpec := fs.String(flags.ChainSpec, "", "")
+	// errors are reported when the command line is parsed by the command
+	_ = fs.Parse(args)
+	if fs.Changed(flags.ChainSpec) {
+		viper.Set(flags.ChainSpec, *chainSpec)
+	}
+}
+
 // defaultRunHandler returns the default run handler for the CLIBuilder.
 func (cb *CLIBuilder[T, ExecutionPayloadT]) defaultRunHandler(
 	logger log.AdvancedLogger[any, sdklog.Logger],
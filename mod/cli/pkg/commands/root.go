@@ -22,6 +22,7 @@ package commands
 
 import (
 	"github.com/berachain/beacon-kit/mod/cli/pkg/config"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	sdkconfig "github.com/cosmos/cosmos-sdk/client/config"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
@@ -78,6 +79,7 @@ func New(name string,
 			return runHandler(cmd)
 		},
 	}
+	flags.AddChainSpecFlag(cmd)
 	return &Root{
 		cmd: cmd,
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/validator"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -79,6 +80,8 @@ func DefaultRootCommandSetup[
 		server.StartCmdWithOptions(appCreator, startCmdOptions),
 		// `status`
 		server.StatusCommand(),
+		// `validator`
+		validator.Commands(chainSpec),
 		// `version`
 		version.NewVersionCommand(),
 	)
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// NewCreateVoluntaryExit creates a new command to sign a voluntary exit.
+func NewCreateVoluntaryExit(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-voluntary-exit",
+		Short: "Creates a signed voluntary exit",
+		Long: `Creates a voluntary exit signed with the validator's BLS key. The
+		arguments are expected in the order of validator index, exit epoch,
+		and genesis validator root. The exit is signed over the fork active
+		at the exit epoch. The signed exit is printed as JSON and can be
+		submitted to the beacon node through
+		POST /eth/v1/beacon/pool/voluntary_exits.`,
+		Args: cobra.ExactArgs(3), //nolint:mnd // The number of arguments.
+		RunE: createVoluntaryExitCmd(chainSpec),
+	}
+
+	cmd.Flags().BoolP(
+		overrideNodeKey, overrideNodeKeyShorthand,
+		defaultOverrideNodeKey, overrideNodeKeyMsg,
+	)
+	cmd.Flags().
+		String(valPrivateKey, defaultValidatorPrivateKey, valPrivateKeyMsg)
+
+	return cmd
+}
+
+// createVoluntaryExitCmd returns a command that signs a voluntary exit.
+func createVoluntaryExitCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		// Get the BLS signer.
+		blsSigner, err := getBLSSigner(cmd)
+		if err != nil {
+			return err
+		}
+
+		validatorIndex, err := parser.ConvertValidatorIndex(args[0])
+		if err != nil {
+			return err
+		}
+
+		epoch, err := parser.ConvertEpoch(args[1])
+		if err != nil {
+			return err
+		}
+
+		genesisValidatorRoot, err := parser.ConvertGenesisValidatorRoot(args[2])
+		if err != nil {
+			return err
+		}
+
+		// Create and sign the voluntary exit over the fork active at the
+		// exit epoch, which is what the state transition verifies against.
+		forkData := types.NewForkData(
+			version.FromUint32[common.Version](
+				chainSpec.ActiveForkVersionForEpoch(epoch),
+			),
+			genesisValidatorRoot,
+		)
+		exit, err := types.CreateAndSignVoluntaryExit(
+			forkData,
+			chainSpec.DomainTypeVoluntaryExit(),
+			blsSigner,
+			epoch,
+			validatorIndex,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Verify the signature before handing the exit out.
+		if err = exit.VerifySignature(
+			forkData,
+			chainSpec.DomainTypeVoluntaryExit(),
+			blsSigner.PublicKey(),
+			signer.BLSSigner{}.VerifySignature,
+		); err != nil {
+			return err
+		}
+
+		bz, err := json.MarshalIndent(exit, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bz)
+		return err
+	}
+}
+
+// getBLSSigner returns a BLS signer based on the override commands key flag.
+func getBLSSigner(
+	cmd *cobra.Command,
+) (crypto.BLSSigner, error) {
+	var legacyKey components.LegacyKey
+	overrideFlag, err := cmd.Flags().GetBool(overrideNodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the BLS signer.
+	if overrideFlag {
+		var validatorPrivKey string
+		validatorPrivKey, err = cmd.Flags().GetString(valPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		if validatorPrivKey == "" {
+			return nil, ErrValidatorPrivateKeyRequired
+		}
+		legacyKey, err = signer.LegacyKeyFromString(validatorPrivKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return components.ProvideBlsSigner(
+		components.BlsSignerInput{
+			AppOpts: client.GetViperFromCmd(cmd),
+			PrivKey: legacyKey,
+		},
+	)
+}
@@ -29,6 +29,7 @@ const (
 	// Beacon Kit Root Flag.
 	beaconKitRoot      = "beacon-kit."
 	BeaconKitAcceptTos = beaconKitRoot + "accept-tos"
+	ChainSpec          = beaconKitRoot + "chain-spec"
 
 	// Builder Config.
 	builderRoot              = beaconKitRoot + "payload-builder."
@@ -46,9 +47,10 @@ const (
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
-	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
+	RPCHealthCheckInterval  = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	VerificationQuorum      = engineRoot + "verification-quorum"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
@@ -69,13 +71,32 @@ const (
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
 
+	// Deposit Service Config.
+	depositRoot             = beaconKitRoot + "deposit."
+	DepositSnapshotPath     = depositRoot + "snapshot-path"
+	DepositSnapshotURL      = depositRoot + "snapshot-url"
+	DepositBatchSize        = depositRoot + "batch-size"
+	DepositRetryInterval    = depositRoot + "retry-interval"
+	DepositMaxRetryInterval = depositRoot + "max-retry-interval"
+
 	// Node API Config.
 	nodeAPIRoot    = beaconKitRoot + "node-api."
 	NodeAPIEnabled = nodeAPIRoot + "enabled"
 	NodeAPIAddress = nodeAPIRoot + "address"
 	NodeAPILogging = nodeAPIRoot + "logging"
+	NodeAPIDebug   = nodeAPIRoot + "debug"
 )
 
+// AddChainSpecFlag adds the chain spec flag to the given command and all of
+// its subcommands.
+func AddChainSpecFlag(rootCmd *cobra.Command) {
+	rootCmd.PersistentFlags().String(
+		ChainSpec,
+		config.DefaultConfig().ChainSpec,
+		"chain spec preset name or path to a TOML or YAML chain spec file",
+	)
+}
+
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
 func AddBeaconKitFlags(startCmd *cobra.Command) {
 	defaultCfg := config.DefaultConfig()
@@ -98,11 +119,21 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCStartupCheckInterval,
 		"rpc startup check interval",
 	)
+	startCmd.Flags().Duration(
+		RPCHealthCheckInterval,
+		defaultCfg.Engine.RPCHealthCheckInterval,
+		"rpc health check interval",
+	)
 	startCmd.Flags().Duration(
 		RPCJWTRefreshInterval,
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().Uint64(
+		VerificationQuorum,
+		defaultCfg.Engine.VerificationQuorum,
+		"number of execution clients that must deem a new payload valid",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
@@ -148,6 +179,31 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().String(
+		DepositSnapshotPath,
+		defaultCfg.Deposit.SnapshotPath,
+		"deposit snapshot file to bootstrap the deposit tree from",
+	)
+	startCmd.Flags().String(
+		DepositSnapshotURL,
+		defaultCfg.Deposit.SnapshotURL,
+		"trusted node api url to bootstrap the deposit tree from",
+	)
+	startCmd.Flags().Uint64(
+		DepositBatchSize,
+		defaultCfg.Deposit.BatchSize,
+		"number of execution blocks whose deposit logs are scanned at once",
+	)
+	startCmd.Flags().Duration(
+		DepositRetryInterval,
+		defaultCfg.Deposit.RetryInterval,
+		"interval after which a failed deposit log scan is retried",
+	)
+	startCmd.Flags().Duration(
+		DepositMaxRetryInterval,
+		defaultCfg.Deposit.MaxRetryInterval,
+		"maximum interval after which a failed deposit log scan is retried",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -163,4 +219,9 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().Bool(
+		NodeAPIDebug,
+		defaultCfg.NodeAPI.Debug,
+		"node api debug endpoints enabled",
+	)
 }
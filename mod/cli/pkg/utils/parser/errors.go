@@ -59,4 +59,15 @@ var (
 	ErrInvalid0xPrefixedHexString = errors.New(
 		"invalid 0x prefixed hex string",
 	)
+
+	// ErrInvalidEpoch is returned when the epoch is invalid.
+	ErrInvalidEpoch = errors.New(
+		"invalid epoch",
+	)
+
+	// ErrInvalidValidatorIndex is returned when the validator index is
+	// invalid.
+	ErrInvalidValidatorIndex = errors.New(
+		"invalid validator index",
+	)
 )
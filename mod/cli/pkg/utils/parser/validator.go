@@ -22,6 +22,7 @@ package parser
 
 import (
 	"math/big"
+	"strconv"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -110,3 +111,23 @@ func ConvertGenesisValidatorRoot(root string) (common.Root, error) {
 	}
 	return common.Root(rootBytes), nil
 }
+
+// ConvertEpoch converts a base 10 string to an epoch.
+func ConvertEpoch(epoch string) (math.Epoch, error) {
+	//nolint:mnd // base 10, 64 bits.
+	e, err := strconv.ParseUint(epoch, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidEpoch
+	}
+	return math.Epoch(e), nil
+}
+
+// ConvertValidatorIndex converts a base 10 string to a validator index.
+func ConvertValidatorIndex(index string) (math.ValidatorIndex, error) {
+	//nolint:mnd // base 10, 64 bits.
+	idx, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidValidatorIndex
+	}
+	return math.ValidatorIndex(idx), nil
+}
@@ -23,11 +23,13 @@ package config
 import (
 	blockstore "github.com/berachain/beacon-kit/mod/beacon/block_store"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	log "github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
@@ -43,18 +45,23 @@ type AppOptions interface {
 // DefaultConfig returns the default configuration for a BeaconKit chain.
 func DefaultConfig() *Config {
 	return &Config{
+		ChainSpec:         spec.TestnetPreset,
 		Engine:            engineclient.DefaultConfig(),
 		Logger:            log.DefaultConfig(),
 		KZG:               kzg.DefaultConfig(),
 		PayloadBuilder:    builder.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
+		Deposit:           deposit.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
 	}
 }
 
 // Config is the main configuration struct for the BeaconKit chain.
 type Config struct {
+	// ChainSpec is the name of a built-in chain spec preset or the path to
+	// a TOML or YAML chain spec file.
+	ChainSpec string `mapstructure:"chain-spec"`
 	// Engine is the configuration for the execution client.
 	Engine engineclient.Config `mapstructure:"engine"`
 	// Logger is the configuration for the logger.
@@ -67,6 +74,8 @@ type Config struct {
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
+	// Deposit is the configuration for the deposit service.
+	Deposit deposit.Config `mapstructure:"deposit"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
 }
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+const (
+	// DevnetPreset is the name of the built-in devnet chain spec.
+	DevnetPreset = "devnet"
+	// TestnetPreset is the name of the built-in testnet chain spec.
+	TestnetPreset = "testnet"
+
+	// cometValuesKey is the key of the CometBFT consensus params, which are
+	// not configurable from a chain spec file.
+	cometValuesKey = "comet-bft-config"
+)
+
+var (
+	// ErrUnsupportedFormat is returned when the chain spec file is neither
+	// TOML nor YAML.
+	ErrUnsupportedFormat = errors.New("chain spec file must be TOML or YAML")
+	// ErrMissingField is returned when the chain spec file does not set a
+	// field.
+	ErrMissingField = errors.New("missing chain spec field")
+	// ErrUnknownField is returned when the chain spec file sets a field
+	// that does not exist.
+	ErrUnknownField = errors.New("unknown chain spec field")
+)
+
+// presets maps the name of every built-in chain spec to its constructor.
+//
+//nolint:gochecknoglobals // static lookup table.
+var presets = map[string]func() chain.Spec[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+]{
+	DevnetPreset:  DevnetChainSpec,
+	TestnetPreset: TestnetChainSpec,
+}
+
+// Load returns the chain spec with the given preset name, or otherwise
+// loads it from the TOML or YAML file at the given path. An empty value
+// selects the testnet preset.
+func Load(presetOrPath string) (chain.Spec[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+], error) {
+	if presetOrPath == "" {
+		presetOrPath = TestnetPreset
+	}
+	if preset, ok := presets[presetOrPath]; ok {
+		return preset(), nil
+	}
+	return FromFile(presetOrPath)
+}
+
+// FromFile loads the chain spec from the TOML or YAML file at the given
+// path. Every field of the spec must be set exactly once and the resulting
+// spec must pass validation. The CometBFT consensus params are always
+// taken from the base spec.
+func FromFile(path string) (chain.Spec[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+], error) {
+	switch filepath.Ext(path) {
+	case ".toml", ".yaml", ".yml":
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedFormat, "%s", path)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read chain spec %s", path)
+	}
+	if err := checkFields(v); err != nil {
+		return nil, errors.Wrapf(err, "invalid chain spec %s", path)
+	}
+
+	var data chain.SpecData[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	]
+	if err := v.Unmarshal(&data, viper.DecodeHook(
+		mapstructure.ComposeDecodeHookFunc(
+			stringToExecutionAddressFunc(),
+			mapstructure.TextUnmarshallerHookFunc(),
+		),
+	)); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode chain spec %s", path)
+	}
+	data.CometValues = BaseSpec().CometValues
+
+	if err := data.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid chain spec %s", path)
+	}
+	return chain.NewChainSpec(data), nil
+}
+
+// checkFields checks that the given viper instance sets every field of the
+// chain spec and nothing else.
+func checkFields(v *viper.Viper) error {
+	var (
+		fields = specFields()
+		keys   = make([]string, 0, len(fields))
+		errs   []error
+	)
+	unknown := v.AllKeys()
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		if _, ok := fields[key]; !ok {
+			errs = append(errs, errors.Wrapf(ErrUnknownField, "%s", key))
+		}
+	}
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !v.IsSet(key) {
+			errs = append(errs, errors.Wrapf(ErrMissingField, "%s", key))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// specFields returns the set of keys of the configurable chain spec fields.
+func specFields() map[string]struct{} {
+	t := reflect.TypeOf(chain.SpecData[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	]{})
+	fields := make(map[string]struct{}, t.NumField())
+	for i := range t.NumField() {
+		key := t.Field(i).Tag.Get("mapstructure")
+		if key != "" && key != cometValuesKey {
+			fields[key] = struct{}{}
+		}
+	}
+	return fields
+}
+
+// stringToExecutionAddressFunc returns a DecodeHookFunc that strictly
+// parses a string into a common.ExecutionAddress.
+func stringToExecutionAddressFunc() mapstructure.DecodeHookFunc {
+	return viperlib.StringTo(
+		func(s string) (common.ExecutionAddress, error) {
+			var addr bytes.B20
+			if err := addr.UnmarshalText([]byte(s)); err != nil {
+				return common.ExecutionAddress{}, err
+			}
+			return common.ExecutionAddress(addr), nil
+		},
+	)
+}
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:lll // test data.
+const specTOML = `
+min-deposit-amount = 1000000000
+max-effective-balance = 32000000000
+ejection-balance = 16000000000
+effective-balance-increment = 1000000000
+slots-per-epoch = 16
+slots-per-historical-root = 8
+min-epochs-to-inactivity-penalty = 4
+max-seed-lookahead = 4
+min-validator-withdrawability-delay = 256
+shard-committee-period = 256
+domain-type-beacon-proposer = "0x00000000"
+domain-type-beacon-attester = "0x01000000"
+domain-type-randao = "0x02000000"
+domain-type-deposit = "0x03000000"
+domain-type-voluntary-exit = "0x04000000"
+domain-type-selection-proof = "0x05000000"
+domain-type-aggregate-and-proof = "0x06000000"
+domain-type-application-mask = "0x00000001"
+deposit-contract-address = "0x4242424242424242424242424242424242424242"
+max-deposits-per-block = 16
+deposit-eth1-chain-id = 1337
+eth1-follow-distance = 1
+target-seconds-per-eth1-block = 3
+max-voluntary-exits-per-block = 16
+deneb-plus-fork-epoch = 10
+electra-fork-epoch = 20
+epochs-per-historical-vector = 8
+epochs-per-slashings-vector = 8
+historical-roots-limit = 8
+validator-registry-limit = 1099511627776
+base-reward-factor = 64
+inactivity-penalty-quotient = 16777216
+proportional-slashing-multiplier = 1
+min-slashing-penalty-quotient = 32
+whistleblower-reward-quotient = 512
+min-per-epoch-churn-limit = 4
+churn-limit-quotient = 65536
+max-withdrawals-per-payload = 16
+max-validators-per-withdrawals-sweep = 16384
+min-epochs-for-blobs-sidecars-request = 4096
+max-blob-commitments-per-block = 16
+max-blobs-per-block = 6
+field-elements-per-blob = 4096
+bytes-per-blob = 131072
+kzg-commitment-inclusion-proof-depth = 17
+`
+
+func writeSpec(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad_Presets(t *testing.T) {
+	for _, preset := range []string{
+		"", spec.DevnetPreset, spec.TestnetPreset,
+	} {
+		_, err := spec.Load(preset)
+		require.NoError(t, err)
+	}
+	require.NoError(t, spec.BaseSpec().Validate())
+}
+
+func TestFromFile(t *testing.T) {
+	cs, err := spec.Load(writeSpec(t, "spec.toml", specTOML))
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), cs.SlotsPerEpoch())
+	require.Equal(t, uint64(1337), cs.DepositEth1ChainID())
+	require.Equal(
+		t,
+		common.NewExecutionAddressFromHex(
+			"0x4242424242424242424242424242424242424242",
+		),
+		cs.DepositContractAddress(),
+	)
+	require.Equal(
+		t, common.DomainType{0x03, 0x00, 0x00, 0x00}, cs.DomainTypeDeposit(),
+	)
+	require.NotNil(t, cs.GetCometBFTConfigForSlot(0))
+}
+
+func TestFromFile_YAML(t *testing.T) {
+	yaml := strings.ReplaceAll(specTOML, " = ", ": ")
+	_, err := spec.FromFile(writeSpec(t, "spec.yaml", yaml))
+	require.NoError(t, err)
+}
+
+func TestFromFile_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected error
+	}{
+		{
+			name:     "UnsupportedFormat",
+			file:     "spec.json",
+			content:  "{}",
+			expected: spec.ErrUnsupportedFormat,
+		},
+		{
+			name: "MissingField",
+			file: "spec.toml",
+			content: strings.ReplaceAll(
+				specTOML, "eth1-follow-distance = 1\n", "",
+			),
+			expected: spec.ErrMissingField,
+		},
+		{
+			name:     "UnknownField",
+			file:     "spec.toml",
+			content:  specTOML + "slots-per-eon = 1\n",
+			expected: spec.ErrUnknownField,
+		},
+		{
+			name: "SlotsPerHistoricalRootNotPowerOfTwo",
+			file: "spec.toml",
+			content: strings.ReplaceAll(
+				specTOML,
+				"slots-per-historical-root = 8",
+				"slots-per-historical-root = 6",
+			),
+			expected: chain.ErrNotPowerOfTwo,
+		},
+		{
+			name: "ForksOutOfOrder",
+			file: "spec.toml",
+			content: strings.ReplaceAll(
+				specTOML,
+				"electra-fork-epoch = 20",
+				"electra-fork-epoch = 5",
+			),
+			expected: chain.ErrInconsistentSpec,
+		},
+		{
+			name: "InconsistentBlobSize",
+			file: "spec.toml",
+			content: strings.ReplaceAll(
+				specTOML,
+				"bytes-per-blob = 131072",
+				"bytes-per-blob = 131071",
+			),
+			expected: chain.ErrInconsistentSpec,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := spec.FromFile(writeSpec(t, tt.file, tt.content))
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
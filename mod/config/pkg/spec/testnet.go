@@ -65,9 +65,12 @@ func BaseSpec() chain.SpecData[
 		EjectionBalance:           uint64(16e9),
 		EffectiveBalanceIncrement: uint64(1e9),
 		// Time parameters constants.
-		SlotsPerEpoch:                32,
-		MinEpochsToInactivityPenalty: 4,
-		SlotsPerHistoricalRoot:       8,
+		SlotsPerEpoch:                    32,
+		MinEpochsToInactivityPenalty:     4,
+		SlotsPerHistoricalRoot:           8,
+		MaxSeedLookahead:                 4,
+		MinValidatorWithdrawabilityDelay: 256,
+		ShardCommitteePeriod:             256,
 		// Signature domains.
 		DomainTypeProposer: common.DomainType{
 			0x00, 0x00, 0x00, 0x00,
@@ -109,9 +112,17 @@ func BaseSpec() chain.SpecData[
 		HistoricalRootsLimit:      8,
 		ValidatorRegistryLimit:    1099511627776,
 		// Max operations per block constants.
-		MaxDepositsPerBlock: 16,
-		// Slashing
+		MaxDepositsPerBlock:       16,
+		MaxVoluntaryExitsPerBlock: 16,
+		// Rewards and penalties constants.
+		BaseRewardFactor:               64,
+		InactivityPenaltyQuotient:      uint64(1 << 24),
 		ProportionalSlashingMultiplier: 1,
+		MinSlashingPenaltyQuotient:     32,
+		WhistleblowerRewardQuotient:    512,
+		// Validator cycle constants.
+		MinPerEpochChurnLimit: 4,
+		ChurnLimitQuotient:    1 << 16,
 		// Capella values.
 		MaxWithdrawalsPerPayload:         16,
 		MaxValidatorsPerWithdrawalsSweep: 1 << 14,
@@ -26,6 +26,11 @@ const TomlTemplate = `
 ###                                BeaconKit                                ###
 ###############################################################################
 
+[beacon-kit]
+# Chain spec of the network, either the name of a built-in preset ("devnet" or
+# "testnet") or the path to a TOML or YAML chain spec file.
+chain-spec = "{{ .BeaconKit.ChainSpec }}"
+
 [beacon-kit.engine]
 # HTTP url of the execution client JSON-RPC endpoint.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
@@ -39,12 +44,48 @@ rpc-timeout = "{{ .BeaconKit.Engine.RPCTimeout }}"
 # Interval for the startup check.
 rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 
+# Interval for the execution client health checks.
+rpc-health-check-interval = "{{ .BeaconKit.Engine.RPCHealthCheckInterval }}"
+
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Number of execution clients, out of the active one and the verifiers,
+# that must deem a new payload VALID for it to be accepted. 0 disables the
+# verification.
+verification-quorum = {{ .BeaconKit.Engine.VerificationQuorum }}
+
+# Standby execution clients to fail over to, in order of preference, when the
+# one at rpc-dial-url is down or syncing. Each entry takes an rpc-dial-url and
+# a jwt-secret-path, e.g.
+#
+# [[beacon-kit.engine.rpc-fallbacks]]
+# rpc-dial-url = "http://localhost:8561"
+# jwt-secret-path = "./jwt-standby.hex"
+{{- range .BeaconKit.Engine.RPCFallbacks }}
+
+[[beacon-kit.engine.rpc-fallbacks]]
+rpc-dial-url = "{{ .RPCDialURL }}"
+jwt-secret-path = "{{ .JWTSecretPath }}"
+{{- end }}
+
+# Additional execution clients new payloads are verified against when
+# verification-quorum is set. Each entry takes an rpc-dial-url and a
+# jwt-secret-path, e.g.
+#
+# [[beacon-kit.engine.rpc-verifiers]]
+# rpc-dial-url = "http://localhost:8571"
+# jwt-secret-path = "./jwt-verifier.hex"
+{{- range .BeaconKit.Engine.RPCVerifiers }}
+
+[[beacon-kit.engine.rpc-verifiers]]
+rpc-dial-url = "{{ .RPCDialURL }}"
+jwt-secret-path = "{{ .JWTSecretPath }}"
+{{- end }}
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -95,6 +136,27 @@ pruner-enabled = "{{ .BeaconKit.BlockStoreService.PrunerEnabled }}"
 # AvailabilityWindow is the number of slots to keep in the store.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+[beacon-kit.deposit]
+# SnapshotPath is the path to an EIP-4881 deposit snapshot file to bootstrap
+# the deposit tree from, when no deposit snapshot has been persisted yet.
+snapshot-path = "{{ .BeaconKit.Deposit.SnapshotPath }}"
+
+# SnapshotURL is the node API URL of a trusted node to fetch the deposit
+# snapshot from, when no snapshot path is set.
+snapshot-url = "{{ .BeaconKit.Deposit.SnapshotURL }}"
+
+# BatchSize is the maximum number of execution blocks whose deposit logs are
+# scanned at once. It is halved whenever a scan fails.
+batch-size = "{{ .BeaconKit.Deposit.BatchSize }}"
+
+# RetryInterval is the interval after which a failed deposit log scan is
+# retried. It is doubled for every consecutive failure.
+retry-interval = "{{ .BeaconKit.Deposit.RetryInterval }}"
+
+# MaxRetryInterval is the maximum interval after which a failed deposit log
+# scan is retried.
+max-retry-interval = "{{ .BeaconKit.Deposit.MaxRetryInterval }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -104,4 +166,7 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+# Debug determines if the debug endpoints of the node API are enabled.
+debug = "{{ .BeaconKit.NodeAPI.Debug }}"
 `
@@ -69,6 +69,14 @@ func (b *BeaconBlock) NewWithVersion(
 			StateRoot:     common.Root{},
 			Body:          &BeaconBlockBody{},
 		}
+	case version.Electra:
+		block = &BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			ParentRoot:    parentBlockRoot,
+			StateRoot:     common.Root{},
+			Body:          &BeaconBlockBody{forkVersion: forkVersion},
+		}
 	default:
 		return &BeaconBlock{}, ErrForkVersionNotSupported
 	}
@@ -85,6 +93,12 @@ func (b *BeaconBlock) NewFromSSZ(
 	switch forkVersion {
 	case version.Deneb:
 		block = &BeaconBlock{}
+	case version.Electra:
+		// The body is set up front, so that it is decoded with the Electra
+		// layout.
+		block = &BeaconBlock{
+			Body: &BeaconBlockBody{forkVersion: forkVersion},
+		}
 	case version.DenebPlus:
 		panic("unsupported fork version")
 	default:
@@ -210,7 +224,7 @@ func (b *BeaconBlock) GetStateRoot() common.Root {
 
 // Version identifies the version of the BeaconBlock.
 func (b *BeaconBlock) Version() uint32 {
-	return version.Deneb
+	return b.Body.Version()
 }
 
 // SetStateRoot sets the state root of the BeaconBlock.
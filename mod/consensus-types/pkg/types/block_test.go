@@ -91,6 +91,35 @@ func TestBeaconBlockFromSSZ(t *testing.T) {
 	require.Equal(t, originalBlock, wrappedBlock)
 }
 
+func TestBeaconBlockFromSSZElectra(t *testing.T) {
+	originalBlock, err := (&types.BeaconBlock{}).NewWithVersion(
+		10, 5, common.Root{1, 2, 3, 4, 5}, version.Electra,
+	)
+	require.NoError(t, err)
+	body := generateElectraBeaconBlockBody()
+	body.SetVoluntaryExits(
+		[]*types.SignedVoluntaryExit{generateSignedVoluntaryExit()},
+	)
+	originalBlock.Body = body
+
+	sszBlock, err := originalBlock.MarshalSSZ()
+	require.NoError(t, err)
+
+	wrappedBlock, err := (&types.BeaconBlock{}).NewFromSSZ(
+		sszBlock, version.Electra,
+	)
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, wrappedBlock.Version())
+	require.Equal(t, originalBlock.HashTreeRoot(), wrappedBlock.HashTreeRoot())
+	require.Equal(
+		t, body.GetVoluntaryExits(), wrappedBlock.GetBody().GetVoluntaryExits(),
+	)
+
+	// A Deneb decoder does not know about the Electra fields.
+	_, err = (&types.BeaconBlock{}).NewFromSSZ(sszBlock, version.Deneb)
+	require.Error(t, err)
+}
+
 func TestBeaconBlockFromSSZForkVersionNotSupported(t *testing.T) {
 	wrappedBlock := &types.BeaconBlock{}
 	_, err := wrappedBlock.NewFromSSZ([]byte{}, 1)
@@ -26,7 +26,9 @@
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -40,13 +42,33 @@ const (
 	// struct.
 	BodyLengthDeneb uint64 = 6
 
+	// BodyLengthElectra is the number of fields in the
+	// BeaconBlockBodyElectra struct.
+	BodyLengthElectra uint64 = 11
+
 	// KZGPositionDeneb is the position of BlobKzgCommitments in the block body.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
+	// KZGPositionElectra is the position of BlobKzgCommitments in the Electra
+	// block body, the new fields are appended after it.
+	KZGPositionElectra = KZGPositionDeneb
+
 	// KZGMerkleIndexDeneb is the merkle index of BlobKzgCommitments' root
 	// in the merkle tree built from the block body.
 	KZGMerkleIndexDeneb = 26
 
+	// KZGMerkleIndexElectra is the merkle index of BlobKzgCommitments' root
+	// in the merkle tree built from the Electra block body.
+	KZGMerkleIndexElectra = 42
+
+	// KZGInclusionProofDepthDeneb is the depth of the inclusion proof of a
+	// KZG commitment in the Deneb block body.
+	KZGInclusionProofDepthDeneb = 8
+
+	// KZGInclusionProofDepthElectra is the depth of the inclusion proof of a
+	// KZG commitment in the Electra block body.
+	KZGInclusionProofDepthElectra = 9
+
 	// ExtraDataSize is the size of ExtraData in bytes.
 	ExtraDataSize = 32
 )
@@ -62,6 +84,15 @@ func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 				ExtraData: make([]byte, ExtraDataSize),
 			},
 		}
+	case version.Electra:
+		return &BeaconBlockBody{
+			Eth1Data: new(Eth1Data),
+			ExecutionPayload: &ExecutionPayload{
+				ExtraData: make([]byte, ExtraDataSize),
+			},
+			ExecutionRequests: new(ExecutionRequests),
+			forkVersion:       forkVersion,
+		}
 	default:
 		panic("unsupported fork version")
 	}
@@ -77,13 +108,17 @@ func BlockBodyKZGOffset(
 	switch cs.ActiveForkVersionForSlot(slot) {
 	case version.Deneb:
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
+	case version.Electra:
+		return KZGMerkleIndexElectra * cs.MaxBlobCommitmentsPerBlock()
 	default:
 		panic("unsupported fork version")
 	}
 }
 
 // BeaconBlockBody represents the body of a beacon block in the Deneb
-// chain.
+// chain. From the Electra fork onwards, the body is extended with the
+// VoluntaryExits, Attestations, SlashingInfo, ExecutionRequests and
+// DepositProofs fields, which are left out of the encoding of Deneb bodies.
 type BeaconBlockBody struct {
 	// RandaoReveal is the reveal of the RANDAO.
 	RandaoReveal crypto.BLSSignature
@@ -97,6 +132,30 @@ type BeaconBlockBody struct {
 	ExecutionPayload *ExecutionPayload
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment
+	// VoluntaryExits is the list of voluntary exits included in the body.
+	VoluntaryExits []*SignedVoluntaryExit
+	// Attestations is the list of attestations included in the body.
+	Attestations []*AttestationData
+	// SlashingInfo is the list of slashing info included in the body.
+	SlashingInfo []*SlashingInfo
+	// ExecutionRequests is the list of requests triggered by the execution
+	// payload of the body, as per EIP-7685.
+	ExecutionRequests *ExecutionRequests
+	// DepositProofs is the list of Merkle branches of the deposits included
+	// in the body, in the same order as the deposits.
+	DepositProofs []*DepositProof
+
+	// forkVersion is the fork version which determines the layout of the
+	// body, left unset for Deneb bodies.
+	forkVersion uint32
+}
+
+// Version returns the fork version of the layout of the BeaconBlockBody.
+func (b *BeaconBlockBody) Version() uint32 {
+	if b != nil && b.forkVersion >= version.Electra {
+		return version.Electra
+	}
+	return version.Deneb
 }
 
 /* -------------------------------------------------------------------------- */
@@ -106,6 +165,9 @@ type BeaconBlockBody struct {
 // SizeSSZ returns the size of the BeaconBlockBody in SSZ.
 func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.Version() == version.Electra {
+		size += 4 + 4 + 4 + 4 + 4
+	}
 	if fixed {
 		return size
 	}
@@ -113,6 +175,18 @@ func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	size += ssz.SizeSliceOfStaticObjects(b.Deposits)
 	size += ssz.SizeDynamicObject(b.ExecutionPayload)
 	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	if b.Version() != version.Electra {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(b.VoluntaryExits)
+	size += ssz.SizeSliceOfStaticObjects(b.Attestations)
+	size += ssz.SizeSliceOfStaticObjects(b.SlashingInfo)
+	if b.ExecutionRequests == nil {
+		size += new(ExecutionRequests).SizeSSZ(false)
+	} else {
+		size += ssz.SizeDynamicObject(b.ExecutionRequests)
+	}
+	size += ssz.SizeSliceOfStaticObjects(b.DepositProofs)
 	return size
 }
 
@@ -120,6 +194,58 @@ func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 //
 //nolint:mnd // TODO: chainspec.
 func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	if b.Version() != version.Electra {
+		b.defineSSZDeneb(codec)
+		return
+	}
+	if b.ExecutionRequests == nil {
+		b.ExecutionRequests = new(ExecutionRequests)
+	}
+
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
+	ssz.DefineStaticObject(codec, &b.Eth1Data)
+	ssz.DefineStaticBytes(codec, &b.Graffiti)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
+	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &b.VoluntaryExits, constants.MaxVoluntaryExitsPerBlock,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &b.Attestations, constants.MaxAttestationsPerBlock,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &b.SlashingInfo, constants.MaxSlashingInfoPerBlock,
+	)
+	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionRequests)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &b.DepositProofs, constants.MaxDepositsPerBlock,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
+	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &b.VoluntaryExits, constants.MaxVoluntaryExitsPerBlock,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &b.Attestations, constants.MaxAttestationsPerBlock,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &b.SlashingInfo, constants.MaxSlashingInfoPerBlock,
+	)
+	ssz.DefineDynamicObjectContent(codec, &b.ExecutionRequests)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &b.DepositProofs, constants.MaxDepositsPerBlock,
+	)
+}
+
+// defineSSZDeneb defines the SSZ serialization of the Deneb BeaconBlockBody.
+//
+//nolint:mnd // TODO: chainspec.
+func (b *BeaconBlockBody) defineSSZDeneb(codec *ssz.Codec) {
 	// Define the static data (fields and dynamic offsets)
 	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
 	ssz.DefineStaticObject(codec, &b.Eth1Data)
@@ -221,6 +347,79 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 		hh.MerkleizeWithMixin(subIndx, numItems, 16)
 	}
 
+	if b.Version() != version.Electra {
+		hh.Merkleize(indx)
+		return nil
+	}
+
+	// Field (6) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > constants.MaxVoluntaryExitsPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, constants.MaxVoluntaryExitsPerBlock)
+	}
+
+	// Field (7) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > constants.MaxAttestationsPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range b.Attestations {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, constants.MaxAttestationsPerBlock)
+	}
+
+	// Field (8) 'SlashingInfo'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.SlashingInfo))
+		if num > constants.MaxSlashingInfoPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range b.SlashingInfo {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, constants.MaxSlashingInfoPerBlock)
+	}
+
+	// Field (9) 'ExecutionRequests'
+	if b.ExecutionRequests == nil {
+		b.ExecutionRequests = new(ExecutionRequests)
+	}
+	if err := b.ExecutionRequests.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	// Field (10) 'DepositProofs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.DepositProofs))
+		if num > constants.MaxDepositsPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range b.DepositProofs {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, constants.MaxDepositsPerBlock)
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -269,29 +468,73 @@ func (b *BeaconBlockBody) SetEth1Data(eth1Data *Eth1Data) {
 	b.Eth1Data = eth1Data
 }
 
-// SetDeposits is not implemented for BeaconBlockDeneb.
+// GetAttestations returns the Attestations of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetAttestations() []*AttestationData {
-	panic("not implemented")
+	return b.Attestations
 }
 
-// SetDeposits is not implemented for BeaconBlockDeneb.
-func (b *BeaconBlockBody) SetAttestations(_ []*AttestationData) {
-	panic("not implemented")
+// SetAttestations sets the Attestations of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetAttestations(attestations []*AttestationData) {
+	b.Attestations = attestations
 }
 
-// GetSlashingInfo is not implemented for BeaconBlockDeneb.
+// GetSlashingInfo returns the SlashingInfo of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetSlashingInfo() []*SlashingInfo {
-	panic("not implemented")
+	return b.SlashingInfo
+}
+
+// SetSlashingInfo sets the SlashingInfo of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetSlashingInfo(slashingInfo []*SlashingInfo) {
+	b.SlashingInfo = slashingInfo
+}
+
+// GetExecutionRequests returns the ExecutionRequests of the BeaconBlockBody.
+func (b *BeaconBlockBody) GetExecutionRequests() *ExecutionRequests {
+	if b.ExecutionRequests == nil {
+		return new(ExecutionRequests)
+	}
+	return b.ExecutionRequests
 }
 
-// SetSlashingInfo is not implemented for BeaconBlockDeneb.
-func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
-	panic("not implemented")
+// SetExecutionRequests sets the ExecutionRequests of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetExecutionRequests(requests *ExecutionRequests) {
+	b.ExecutionRequests = requests
+}
+
+// GetExecutionRequestsList returns the ExecutionRequests of the
+// BeaconBlockBody as the list of typed requests of EIP-7685.
+func (b *BeaconBlockBody) GetExecutionRequestsList() ([]bytes.Bytes, error) {
+	return b.GetExecutionRequests().GetExecutionRequestsList()
+}
+
+// SetExecutionRequestsList sets the ExecutionRequests of the BeaconBlockBody
+// from the list of typed requests of EIP-7685.
+func (b *BeaconBlockBody) SetExecutionRequestsList(
+	list []bytes.Bytes,
+) error {
+	requests, err := DecodeExecutionRequestsList(list)
+	if err != nil {
+		return err
+	}
+	b.ExecutionRequests = requests
+	return nil
+}
+
+// GetDepositRequests returns the deposits requested by the execution
+// payload of the BeaconBlockBody.
+func (b *BeaconBlockBody) GetDepositRequests() []*Deposit {
+	return b.GetExecutionRequests().GetDeposits()
+}
+
+// GetWithdrawalRequests returns the withdrawal requests triggered by the
+// execution payload of the BeaconBlockBody.
+func (b *BeaconBlockBody) GetWithdrawalRequests() []*WithdrawalRequest {
+	return b.GetExecutionRequests().Withdrawals
 }
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
-	return []common.Root{
+	roots := []common.Root{
 		common.Root(b.GetRandaoReveal().HashTreeRoot()),
 		b.Eth1Data.HashTreeRoot(),
 		common.Root(b.GetGraffiti().HashTreeRoot()),
@@ -300,10 +543,24 @@ func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 		// I think this is a bug.
 		common.Root{},
 	}
+	if b.Version() != version.Electra {
+		return roots
+	}
+	return append(
+		roots,
+		VoluntaryExits(b.GetVoluntaryExits()).HashTreeRoot(),
+		Attestations(b.GetAttestations()).HashTreeRoot(),
+		SlashingInfos(b.GetSlashingInfo()).HashTreeRoot(),
+		b.GetExecutionRequests().HashTreeRoot(),
+		DepositProofs(b.GetDepositProofs()).HashTreeRoot(),
+	)
 }
 
 // Length returns the number of fields in the BeaconBlockBody struct.
 func (b *BeaconBlockBody) Length() uint64 {
+	if b.Version() == version.Electra {
+		return BodyLengthElectra
+	}
 	return BodyLengthDeneb
 }
 
@@ -341,3 +598,42 @@ func (b *BeaconBlockBody) GetDeposits() []*Deposit {
 func (b *BeaconBlockBody) SetDeposits(deposits []*Deposit) {
 	b.Deposits = deposits
 }
+
+// GetDepositProofs returns the DepositProofs of the BeaconBlockBody.
+func (b *BeaconBlockBody) GetDepositProofs() []*DepositProof {
+	return b.DepositProofs
+}
+
+// SetDepositProofs sets the DepositProofs of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetDepositProofs(proofs []*DepositProof) {
+	b.DepositProofs = proofs
+}
+
+// GetDepositBranches returns the Merkle branches of the deposit proofs of the
+// BeaconBlockBody.
+func (b *BeaconBlockBody) GetDepositBranches() [][]common.Root {
+	branches := make([][]common.Root, len(b.DepositProofs))
+	for i, proof := range b.DepositProofs {
+		branches[i] = proof.GetBranch()
+	}
+	return branches
+}
+
+// SetDepositBranches sets the deposit proofs of the BeaconBlockBody from the
+// given Merkle branches.
+func (b *BeaconBlockBody) SetDepositBranches(branches [][]common.Root) {
+	b.DepositProofs = make([]*DepositProof, len(branches))
+	for i, branch := range branches {
+		b.DepositProofs[i] = NewDepositProof(branch)
+	}
+}
+
+// GetVoluntaryExits returns the VoluntaryExits of the BeaconBlockBody.
+func (b *BeaconBlockBody) GetVoluntaryExits() []*SignedVoluntaryExit {
+	return b.VoluntaryExits
+}
+
+// SetVoluntaryExits sets the VoluntaryExits of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetVoluntaryExits(exits []*SignedVoluntaryExit) {
+	b.VoluntaryExits = exits
+}
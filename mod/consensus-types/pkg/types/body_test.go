@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -46,6 +47,18 @@ func generateBeaconBlockBody() types.BeaconBlockBody {
 	}
 }
 
+// generateElectraBeaconBlockBody generates a block body with the layout of
+// the Electra fork.
+func generateElectraBeaconBlockBody() *types.BeaconBlockBody {
+	body := new(types.BeaconBlockBody).Empty(version.Electra)
+	body.RandaoReveal = [96]byte{1, 2, 3}
+	body.Graffiti = [32]byte{4, 5, 6}
+	body.Deposits = []*types.Deposit{}
+	body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+	body.BlobKzgCommitments = []eip4844.KZGCommitment{}
+	return body
+}
+
 func TestBeaconBlockBodyBase(t *testing.T) {
 	body := types.BeaconBlockBody{
 		RandaoReveal: [96]byte{1, 2, 3},
@@ -119,6 +132,25 @@ func TestBeaconBlockBody_SetDeposits(t *testing.T) {
 	require.Equal(t, deposits, body.GetDeposits())
 }
 
+func TestBeaconBlockBody_SetVoluntaryExits(t *testing.T) {
+	body := generateElectraBeaconBlockBody()
+	exits := []*types.SignedVoluntaryExit{generateSignedVoluntaryExit()}
+	body.SetVoluntaryExits(exits)
+	require.Equal(t, exits, body.GetVoluntaryExits())
+
+	// The exits must survive an SSZ round trip and be committed to by both
+	// hashers.
+	data, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	unmarshalled := new(types.BeaconBlockBody).Empty(version.Electra)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, exits, unmarshalled.GetVoluntaryExits())
+
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, body.HashTreeRoot(), common.Root(tree.Hash()))
+}
+
 func TestBeaconBlockBody_MarshalSSZ(t *testing.T) {
 	body := types.BeaconBlockBody{
 		RandaoReveal:       [96]byte{1, 2, 3},
@@ -160,3 +192,115 @@ func TestBeaconBlockBody_Empty(t *testing.T) {
 	body := blockBody.Empty(version.Deneb)
 	require.NotNil(t, body)
 }
+
+func TestBeaconBlockBody_SetAttestations(t *testing.T) {
+	body := generateElectraBeaconBlockBody()
+	attestations := []*types.AttestationData{
+		{Slot: 1, Index: 0, BeaconBlockRoot: common.Root{1}},
+		{Slot: 1, Index: 2, BeaconBlockRoot: common.Root{1}},
+	}
+	body.SetAttestations(attestations)
+	require.Equal(t, attestations, body.GetAttestations())
+
+	data, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	unmarshalled := new(types.BeaconBlockBody).Empty(version.Electra)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, attestations, unmarshalled.GetAttestations())
+
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, body.HashTreeRoot(), common.Root(tree.Hash()))
+}
+
+func TestBeaconBlockBody_SetSlashingInfo(t *testing.T) {
+	body := generateElectraBeaconBlockBody()
+	slashingInfo := []*types.SlashingInfo{
+		{Slot: 5, Index: 1},
+		{Slot: 6, Index: 3},
+	}
+	body.SetSlashingInfo(slashingInfo)
+	require.Equal(t, slashingInfo, body.GetSlashingInfo())
+
+	data, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	unmarshalled := new(types.BeaconBlockBody).Empty(version.Electra)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, slashingInfo, unmarshalled.GetSlashingInfo())
+
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, body.HashTreeRoot(), common.Root(tree.Hash()))
+}
+
+func TestBeaconBlockBody_SetDepositBranches(t *testing.T) {
+	body := generateElectraBeaconBlockBody()
+	branches := make([][]common.Root, 2)
+	for i := range branches {
+		branches[i] = make([]common.Root, constants.DepositProofLength)
+		branches[i][0] = common.Root{byte(i + 1)}
+	}
+	body.SetDepositBranches(branches)
+	require.Len(t, body.GetDepositProofs(), 2)
+	require.Equal(t, branches, body.GetDepositBranches())
+
+	data, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	unmarshalled := new(types.BeaconBlockBody).Empty(version.Electra)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, branches, unmarshalled.GetDepositBranches())
+
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, body.HashTreeRoot(), common.Root(tree.Hash()))
+}
+
+func TestBeaconBlockBody_ExecutionRequests(t *testing.T) {
+	body := generateElectraBeaconBlockBody()
+	require.NotNil(t, body.GetExecutionRequests())
+
+	requests := generateExecutionRequests()
+	list, err := requests.GetExecutionRequestsList()
+	require.NoError(t, err)
+	require.NoError(t, body.SetExecutionRequestsList(list))
+	require.Len(t, body.GetDepositRequests(), 1)
+	require.Equal(t, requests.Withdrawals, body.GetWithdrawalRequests())
+
+	bz, err := body.MarshalSSZ()
+	require.NoError(t, err)
+
+	unmarshalled := new(types.BeaconBlockBody).Empty(version.Electra)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+	require.Equal(t, requests.Deposits, unmarshalled.ExecutionRequests.Deposits)
+	require.Equal(
+		t, body.HashTreeRoot(), unmarshalled.HashTreeRoot(),
+	)
+	require.Len(t, body.GetTopLevelRoots(), int(types.BodyLengthElectra))
+}
+
+func TestBeaconBlockBody_DenebLayout(t *testing.T) {
+	body := generateBeaconBlockBody()
+	body.SetVoluntaryExits(
+		[]*types.SignedVoluntaryExit{generateSignedVoluntaryExit()},
+	)
+	require.Equal(t, version.Deneb, body.Version())
+	require.Equal(t, types.BodyLengthDeneb, body.Length())
+	require.Len(t, body.GetTopLevelRoots(), int(types.BodyLengthDeneb))
+
+	// The fields added in Electra are not part of the Deneb encoding.
+	data, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	unmarshalled := new(types.BeaconBlockBody)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Empty(t, unmarshalled.GetVoluntaryExits())
+	require.Equal(t, body.HashTreeRoot(), unmarshalled.HashTreeRoot())
+
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, body.HashTreeRoot(), common.Root(tree.Hash()))
+
+	// An Electra decoder rejects the Deneb encoding.
+	require.Error(
+		t, new(types.BeaconBlockBody).Empty(version.Electra).UnmarshalSSZ(data),
+	)
+}
@@ -29,8 +29,12 @@ import (
 	"github.com/karalabe/ssz"
 )
 
-// DepositSize is the size of the SSZ encoding of a Deposit.
-const DepositSize = 192 // 48 + 32 + 8 + 96 + 8
+const (
+	// DepositSize is the size of the SSZ encoding of a Deposit.
+	DepositSize = 192 // 48 + 32 + 8 + 96 + 8
+	// depositDataSize is the size of the SSZ encoding of the deposit data.
+	depositDataSize = 184 // 48 + 32 + 8 + 96
+)
 
 // Compile-time assertions to ensure Deposit implements necessary interfaces.
 var (
@@ -202,6 +206,17 @@ func (d *Deposit) GetIndex() math.U64 {
 	return math.U64(d.Index)
 }
 
+// GetDepositDataRoot returns the hash tree root of the deposit data, which is
+// the leaf of the deposit in the deposit contract Merkle tree.
+func (d *Deposit) GetDepositDataRoot() common.Root {
+	return ssz.HashSequential(&depositData{
+		Pubkey:      d.Pubkey,
+		Credentials: d.Credentials,
+		Amount:      d.Amount,
+		Signature:   d.Signature,
+	})
+}
+
 // GetSignature returns the signature of the deposit data.
 func (d *Deposit) GetSignature() crypto.BLSSignature {
 	return d.Signature
@@ -211,3 +226,29 @@ func (d *Deposit) GetSignature() crypto.BLSSignature {
 func (d *Deposit) GetWithdrawalCredentials() WithdrawalCredentials {
 	return d.Credentials
 }
+
+/* -------------------------------------------------------------------------- */
+/*                                 DepositData                                */
+/* -------------------------------------------------------------------------- */
+
+// depositData is the data of a deposit as it is inserted into the deposit
+// contract Merkle tree, i.e. the deposit without its index.
+type depositData struct {
+	Pubkey      crypto.BLSPubkey
+	Credentials WithdrawalCredentials
+	Amount      math.Gwei
+	Signature   crypto.BLSSignature
+}
+
+// SizeSSZ returns the SSZ encoded size of the depositData object.
+func (*depositData) SizeSSZ() uint32 {
+	return depositDataSize
+}
+
+// DefineSSZ defines the SSZ encoding for the depositData object.
+func (d *depositData) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &d.Pubkey)
+	ssz.DefineStaticBytes(c, &d.Credentials)
+	ssz.DefineUint64(c, &d.Amount)
+	ssz.DefineStaticBytes(c, &d.Signature)
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// DepositProofSize is the size of the SSZ encoding of a DepositProof.
+const DepositProofSize = 1056 // 33 * 32
+
+// Compile-time assertions to ensure DepositProof implements necessary
+// interfaces.
+var (
+	_ ssz.StaticObject                    = (*DepositProof)(nil)
+	_ constraints.SSZMarshallableRootable = (*DepositProof)(nil)
+)
+
+// DepositProof is the Merkle branch of a deposit against the deposit root of
+// the eth1 data, with the deposit count mixed in as the last element. It is
+// kept apart from the Deposit, such that the encoding of the deposits of
+// blocks before Electra is unchanged.
+type DepositProof struct {
+	// Branch is the Merkle branch of the deposit data.
+	Branch [constants.DepositProofLength]common.Root `json:"branch"`
+}
+
+// NewDepositProof creates a new DepositProof from the given branch.
+func NewDepositProof(branch []common.Root) *DepositProof {
+	p := &DepositProof{}
+	copy(p.Branch[:], branch)
+	return p
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// DefineSSZ defines the SSZ encoding for the DepositProof object.
+func (p *DepositProof) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineArrayOfStaticBytes[
+		[constants.DepositProofLength]common.Root, common.Root,
+	](c, &p.Branch)
+}
+
+// MarshalSSZ marshals the DepositProof object to SSZ format.
+func (p *DepositProof) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, p.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, p)
+}
+
+// UnmarshalSSZ unmarshals the DepositProof object from SSZ format.
+func (p *DepositProof) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, p)
+}
+
+// SizeSSZ returns the SSZ encoded size of the DepositProof object.
+func (*DepositProof) SizeSSZ() uint32 {
+	return DepositProofSize
+}
+
+// HashTreeRoot computes the Merkleization of the DepositProof object.
+func (p *DepositProof) HashTreeRoot() common.Root {
+	return ssz.HashSequential(p)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the DepositProof object into a pre-allocated byte
+// slice.
+func (p *DepositProof) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := p.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the DepositProof object with a hasher.
+func (p *DepositProof) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Branch'
+	{
+		subIndx := hh.Index()
+		for _, root := range p.Branch {
+			hh.Append(root[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the DepositProof object.
+func (p *DepositProof) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(p)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Getters                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetBranch returns the Merkle branch of the deposit against the deposit
+// root.
+func (p *DepositProof) GetBranch() []common.Root {
+	return p.Branch[:]
+}
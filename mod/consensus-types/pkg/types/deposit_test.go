@@ -21,6 +21,8 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"io"
 	"testing"
 
@@ -104,6 +106,9 @@ func TestDeposit_HashTreeRootWith(t *testing.T) {
 	require.NotNil(t, hasher)
 	err := deposit.HashTreeRootWith(hasher)
 	require.NoError(t, err)
+	root, err := hasher.HashRoot()
+	require.NoError(t, err)
+	require.Equal(t, deposit.HashTreeRoot(), common.Root(root))
 }
 
 func TestDeposit_GetTree(t *testing.T) {
@@ -114,7 +119,7 @@ func TestDeposit_GetTree(t *testing.T) {
 
 func TestDeposit_UnmarshalSSZ_ErrSize(t *testing.T) {
 	// Create a byte slice of incorrect size
-	buf := make([]byte, 10) // size less than 192
+	buf := make([]byte, 10) // size less than 1248
 
 	var unmarshalledDeposit types.Deposit
 	err := unmarshalledDeposit.UnmarshalSSZ(buf)
@@ -151,3 +156,36 @@ func TestDeposit_Getters(t *testing.T) {
 	require.Equal(t, deposit.Signature, deposit.GetSignature())
 	require.Equal(t, math.U64(deposit.Index), deposit.GetIndex())
 }
+
+func TestDeposit_GetDepositDataRoot(t *testing.T) {
+	deposit := generateValidDeposit()
+	deposit.Pubkey = crypto.BLSPubkey{0x01, 0x02}
+	deposit.Credentials = types.WithdrawalCredentials{0x01}
+	deposit.Signature = crypto.BLSSignature{0x03, 0x04}
+
+	// Compute the leaf the way the deposit contract does.
+	hash := func(chunks ...[]byte) []byte {
+		h := sha256.New()
+		for _, c := range chunks {
+			h.Write(c)
+		}
+		return h.Sum(nil)
+	}
+	zeroChunk := make([]byte, 32)
+	amount := make([]byte, 32)
+	binary.LittleEndian.PutUint64(amount, uint64(deposit.Amount))
+	pubkeyRoot := hash(deposit.Pubkey[:], make([]byte, 16))
+	signatureRoot := hash(
+		hash(deposit.Signature[:64]),
+		hash(deposit.Signature[64:], zeroChunk),
+	)
+	expected := hash(
+		hash(pubkeyRoot, deposit.Credentials[:]),
+		hash(amount, signatureRoot),
+	)
+	require.Equal(t, common.Root(expected), deposit.GetDepositDataRoot())
+
+	// The index is not part of the deposit data.
+	deposit.Index++
+	require.Equal(t, common.Root(expected), deposit.GetDepositDataRoot())
+}
@@ -27,6 +27,10 @@ var (
 	// match.
 	ErrDepositMessage = errors.New("invalid deposit message")
 
+	// ErrVoluntaryExitSignature is an error for when the voluntary exit
+	// signature doesn't match.
+	ErrVoluntaryExitSignature = errors.New("invalid voluntary exit signature")
+
 	// ErrInvalidWithdrawalCredentials is an error for when the.
 	ErrInvalidWithdrawalCredentials = errors.New(
 		"invalid withdrawal credentials",
@@ -36,6 +40,10 @@ var (
 	// version is not supported.
 	ErrForkVersionNotSupported = errors.New("fork version not supported")
 
+	// ErrInvalidExecutionRequests is an error for when the execution
+	// requests received from the execution client are malformed.
+	ErrInvalidExecutionRequests = errors.New("invalid execution requests")
+
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
 )
@@ -106,3 +106,14 @@ func TestEth1Data_GetDepositCount(t *testing.T) {
 
 	require.Equal(t, uint64(10), count.Unwrap())
 }
+
+func TestEth1Data_GetDepositRootAndBlockHash(t *testing.T) {
+	eth1Data := &types.Eth1Data{
+		DepositRoot:  common.Root{0x01},
+		DepositCount: 10,
+		BlockHash:    common.ExecutionHash{0x02},
+	}
+
+	require.Equal(t, common.Root{0x01}, eth1Data.GetDepositRoot())
+	require.Equal(t, common.ExecutionHash{0x02}, eth1Data.GetBlockHash())
+}
@@ -0,0 +1,321 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// DepositRequestSize is the size of the SSZ encoding of a DepositRequest.
+	DepositRequestSize = 192 // 48 + 32 + 8 + 96 + 8
+
+	// WithdrawalRequestSize is the size of the SSZ encoding of a
+	// WithdrawalRequest.
+	WithdrawalRequestSize = 76 // 20 + 48 + 8
+
+	// ConsolidationRequestSize is the size of the SSZ encoding of a
+	// ConsolidationRequest.
+	ConsolidationRequestSize = 116 // 20 + 48 + 48
+)
+
+// Compile-time assertions to ensure the execution request types implement
+// the necessary interfaces.
+var (
+	_ ssz.StaticObject                    = (*DepositRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*DepositRequest)(nil)
+	_ ssz.StaticObject                    = (*WithdrawalRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*WithdrawalRequest)(nil)
+	_ ssz.StaticObject                    = (*ConsolidationRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*ConsolidationRequest)(nil)
+)
+
+// DepositRequest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#depositrequest
+//
+//nolint:lll
+type DepositRequest struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// Credentials are the withdrawal credentials of the validator.
+	Credentials WithdrawalCredentials `json:"withdrawal_credentials"`
+	// Amount is the deposit amount in gwei.
+	Amount math.Gwei `json:"amount"`
+	// Signature is the signature of the deposit data.
+	Signature crypto.BLSSignature `json:"signature"`
+	// Index is the index of the deposit in the deposit contract.
+	Index uint64 `json:"index"`
+}
+
+// WithdrawalRequest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#withdrawalrequest
+//
+//nolint:lll
+type WithdrawalRequest struct {
+	// SourceAddress is the execution address that requested the withdrawal.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// ValidatorPubkey is the public key of the validator to withdraw from.
+	ValidatorPubkey crypto.BLSPubkey `json:"validator_pubkey"`
+	// Amount is the amount to withdraw in gwei, zero requesting a full exit.
+	Amount math.Gwei `json:"amount"`
+}
+
+// ConsolidationRequest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#consolidationrequest
+//
+//nolint:lll
+type ConsolidationRequest struct {
+	// SourceAddress is the execution address that requested the
+	// consolidation.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// SourcePubkey is the public key of the validator to consolidate from.
+	SourcePubkey crypto.BLSPubkey `json:"source_pubkey"`
+	// TargetPubkey is the public key of the validator to consolidate into.
+	TargetPubkey crypto.BLSPubkey `json:"target_pubkey"`
+}
+
+// ToDeposit returns the deposit requested by the DepositRequest.
+func (d *DepositRequest) ToDeposit() *Deposit {
+	return NewDeposit(
+		d.Pubkey, d.Credentials, d.Amount, d.Signature, d.Index,
+	)
+}
+
+// GetSourceAddress returns the execution address that sent the
+// WithdrawalRequest.
+func (w *WithdrawalRequest) GetSourceAddress() common.ExecutionAddress {
+	return w.SourceAddress
+}
+
+// GetValidatorPubkey returns the public key of the validator to withdraw
+// from.
+func (w *WithdrawalRequest) GetValidatorPubkey() crypto.BLSPubkey {
+	return w.ValidatorPubkey
+}
+
+// GetAmount returns the amount to withdraw, zero requesting a full exit.
+func (w *WithdrawalRequest) GetAmount() math.Gwei {
+	return w.Amount
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the DepositRequest object in SSZ encoding.
+func (*DepositRequest) SizeSSZ() uint32 {
+	return DepositRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the DepositRequest object.
+func (d *DepositRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &d.Pubkey)
+	ssz.DefineStaticBytes(codec, &d.Credentials)
+	ssz.DefineUint64(codec, &d.Amount)
+	ssz.DefineStaticBytes(codec, &d.Signature)
+	ssz.DefineUint64(codec, &d.Index)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the DepositRequest object.
+func (d *DepositRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
+
+// MarshalSSZ marshals the DepositRequest object to SSZ format.
+func (d *DepositRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, d.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, d)
+}
+
+// UnmarshalSSZ unmarshals the DepositRequest object from SSZ format.
+func (d *DepositRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, d)
+}
+
+// SizeSSZ returns the size of the WithdrawalRequest object in SSZ encoding.
+func (*WithdrawalRequest) SizeSSZ() uint32 {
+	return WithdrawalRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the WithdrawalRequest object.
+func (w *WithdrawalRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &w.SourceAddress)
+	ssz.DefineStaticBytes(codec, &w.ValidatorPubkey)
+	ssz.DefineUint64(codec, &w.Amount)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the WithdrawalRequest
+// object.
+func (w *WithdrawalRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(w)
+}
+
+// MarshalSSZ marshals the WithdrawalRequest object to SSZ format.
+func (w *WithdrawalRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, w.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, w)
+}
+
+// UnmarshalSSZ unmarshals the WithdrawalRequest object from SSZ format.
+func (w *WithdrawalRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, w)
+}
+
+// SizeSSZ returns the size of the ConsolidationRequest object in SSZ
+// encoding.
+func (*ConsolidationRequest) SizeSSZ() uint32 {
+	return ConsolidationRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the ConsolidationRequest object.
+func (c *ConsolidationRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &c.SourceAddress)
+	ssz.DefineStaticBytes(codec, &c.SourcePubkey)
+	ssz.DefineStaticBytes(codec, &c.TargetPubkey)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ConsolidationRequest
+// object.
+func (c *ConsolidationRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
+
+// MarshalSSZ marshals the ConsolidationRequest object to SSZ format.
+func (c *ConsolidationRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, c.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// UnmarshalSSZ unmarshals the ConsolidationRequest object from SSZ format.
+func (c *ConsolidationRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, c)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the DepositRequest object into a pre-allocated byte
+// slice.
+func (d *DepositRequest) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := d.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, bz...), nil
+}
+
+// HashTreeRootWith ssz hashes the DepositRequest object with a hasher.
+func (d *DepositRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(d.Pubkey[:])
+
+	// Field (1) 'Credentials'
+	hh.PutBytes(d.Credentials[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	hh.PutBytes(d.Signature[:])
+
+	// Field (4) 'Index'
+	hh.PutUint64(d.Index)
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the DepositRequest object.
+func (d *DepositRequest) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(d)
+}
+
+// MarshalSSZTo marshals the WithdrawalRequest object into a pre-allocated
+// byte slice.
+func (w *WithdrawalRequest) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := w.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, bz...), nil
+}
+
+// HashTreeRootWith ssz hashes the WithdrawalRequest object with a hasher.
+func (w *WithdrawalRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'SourceAddress'
+	hh.PutBytes(w.SourceAddress[:])
+
+	// Field (1) 'ValidatorPubkey'
+	hh.PutBytes(w.ValidatorPubkey[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(w.Amount))
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the WithdrawalRequest object.
+func (w *WithdrawalRequest) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(w)
+}
+
+// MarshalSSZTo marshals the ConsolidationRequest object into a
+// pre-allocated byte slice.
+func (c *ConsolidationRequest) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := c.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, bz...), nil
+}
+
+// HashTreeRootWith ssz hashes the ConsolidationRequest object with a hasher.
+func (c *ConsolidationRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'SourceAddress'
+	hh.PutBytes(c.SourceAddress[:])
+
+	// Field (1) 'SourcePubkey'
+	hh.PutBytes(c.SourcePubkey[:])
+
+	// Field (2) 'TargetPubkey'
+	hh.PutBytes(c.TargetPubkey[:])
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the ConsolidationRequest object.
+func (c *ConsolidationRequest) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(c)
+}
@@ -0,0 +1,341 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// The request types of the execution requests, as per EIP-7685.
+const (
+	// DepositRequestType is the type of the deposit requests (EIP-6110).
+	DepositRequestType byte = 0x00
+	// WithdrawalRequestType is the type of the withdrawal requests
+	// (EIP-7002).
+	WithdrawalRequestType byte = 0x01
+	// ConsolidationRequestType is the type of the consolidation requests
+	// (EIP-7251).
+	ConsolidationRequestType byte = 0x02
+)
+
+// Compile-time assertions to ensure ExecutionRequests implements the
+// necessary interfaces.
+var (
+	_ ssz.DynamicObject                   = (*ExecutionRequests)(nil)
+	_ constraints.SSZMarshallableRootable = (*ExecutionRequests)(nil)
+)
+
+// ExecutionRequests as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#executionrequests
+//
+//nolint:lll
+type ExecutionRequests struct {
+	// Deposits is the list of deposit requests.
+	Deposits []*DepositRequest `json:"deposits"`
+	// Withdrawals is the list of withdrawal requests.
+	Withdrawals []*WithdrawalRequest `json:"withdrawals"`
+	// Consolidations is the list of consolidation requests.
+	Consolidations []*ConsolidationRequest `json:"consolidations"`
+}
+
+// DecodeExecutionRequestsList decodes the execution requests from the list
+// of typed requests exchanged with the execution client, as per EIP-7685.
+// Each entry is the request type followed by the SSZ encoding of the list of
+// requests of that type, and the entries must be in strictly ascending order
+// of their request type.
+func DecodeExecutionRequestsList(
+	list []bytes.Bytes,
+) (*ExecutionRequests, error) {
+	var (
+		requests = new(ExecutionRequests)
+		prevType = -1
+		err      error
+	)
+	for _, entry := range list {
+		if len(entry) < 2 {
+			return nil, errors.Wrap(
+				ErrInvalidExecutionRequests, "empty request entry",
+			)
+		}
+
+		requestType := entry[0]
+		if int(requestType) <= prevType {
+			return nil, errors.Wrapf(
+				ErrInvalidExecutionRequests,
+				"request type %d out of order", requestType,
+			)
+		}
+		prevType = int(requestType)
+
+		switch requestType {
+		case DepositRequestType:
+			requests.Deposits, err = decodeRequests[DepositRequest](
+				entry[1:], DepositRequestSize,
+				constants.MaxDepositRequestsPerPayload,
+			)
+		case WithdrawalRequestType:
+			requests.Withdrawals, err = decodeRequests[WithdrawalRequest](
+				entry[1:], WithdrawalRequestSize,
+				constants.MaxWithdrawalRequestsPerPayload,
+			)
+		case ConsolidationRequestType:
+			requests.Consolidations, err = decodeRequests[ConsolidationRequest](
+				entry[1:], ConsolidationRequestSize,
+				constants.MaxConsolidationRequestsPerPayload,
+			)
+		default:
+			err = errors.Wrapf(
+				ErrInvalidExecutionRequests,
+				"unknown request type %d", requestType,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return requests, nil
+}
+
+// GetExecutionRequestsList returns the list of typed requests to exchange
+// with the execution client, as per EIP-7685. Request types without any
+// request are omitted.
+func (e *ExecutionRequests) GetExecutionRequestsList() (
+	[]bytes.Bytes, error,
+) {
+	var list []bytes.Bytes
+	for _, entry := range []struct {
+		requestType byte
+		requests    []constraints.SSZMarshallable
+	}{
+		{DepositRequestType, toMarshallables(e.Deposits)},
+		{WithdrawalRequestType, toMarshallables(e.Withdrawals)},
+		{ConsolidationRequestType, toMarshallables(e.Consolidations)},
+	} {
+		if len(entry.requests) == 0 {
+			continue
+		}
+
+		data := bytes.Bytes{entry.requestType}
+		for _, request := range entry.requests {
+			bz, err := request.MarshalSSZ()
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, bz...)
+		}
+		list = append(list, data)
+	}
+	return list, nil
+}
+
+// GetDeposits returns the deposits requested by the deposit requests.
+func (e *ExecutionRequests) GetDeposits() []*Deposit {
+	deposits := make([]*Deposit, len(e.Deposits))
+	for i, request := range e.Deposits {
+		deposits[i] = request.ToDeposit()
+	}
+	return deposits
+}
+
+// decodeRequests decodes the concatenated SSZ encodings of requests of the
+// given size.
+func decodeRequests[
+	RequestT any,
+	PRequestT interface {
+		*RequestT
+		UnmarshalSSZ([]byte) error
+	},
+](data []byte, size int, limit uint64) ([]PRequestT, error) {
+	//#nosec:G701 // the length of a slice is never negative.
+	if len(data)%size != 0 || uint64(len(data)/size) > limit {
+		return nil, errors.Wrapf(
+			ErrInvalidExecutionRequests,
+			"invalid requests length %d", len(data),
+		)
+	}
+
+	requests := make([]PRequestT, 0, len(data)/size)
+	for i := 0; i < len(data); i += size {
+		request := PRequestT(new(RequestT))
+		if err := request.UnmarshalSSZ(data[i : i+size]); err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// toMarshallables converts a list of requests to a list of SSZ
+// marshallables.
+func toMarshallables[RequestT constraints.SSZMarshallable](
+	requests []RequestT,
+) []constraints.SSZMarshallable {
+	marshallables := make([]constraints.SSZMarshallable, len(requests))
+	for i, request := range requests {
+		marshallables[i] = request
+	}
+	return marshallables
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the ExecutionRequests object in SSZ encoding.
+func (e *ExecutionRequests) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 4 + 4 + 4
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(e.Deposits)
+	size += ssz.SizeSliceOfStaticObjects(e.Withdrawals)
+	size += ssz.SizeSliceOfStaticObjects(e.Consolidations)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the ExecutionRequests object.
+func (e *ExecutionRequests) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &e.Deposits, constants.MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &e.Withdrawals, constants.MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &e.Consolidations,
+		constants.MaxConsolidationRequestsPerPayload,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &e.Deposits, constants.MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &e.Withdrawals, constants.MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &e.Consolidations,
+		constants.MaxConsolidationRequestsPerPayload,
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ExecutionRequests
+// object.
+func (e *ExecutionRequests) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// MarshalSSZ marshals the ExecutionRequests object to SSZ format.
+func (e *ExecutionRequests) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the ExecutionRequests object from SSZ format.
+func (e *ExecutionRequests) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the ExecutionRequests object into a pre-allocated
+// byte slice.
+func (e *ExecutionRequests) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := e.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, bz...), nil
+}
+
+// HashTreeRootWith ssz hashes the ExecutionRequests object with a hasher.
+func (e *ExecutionRequests) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Deposits))
+		if num > constants.MaxDepositRequestsPerPayload {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range e.Deposits {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxDepositRequestsPerPayload,
+		)
+	}
+
+	// Field (1) 'Withdrawals'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Withdrawals))
+		if num > constants.MaxWithdrawalRequestsPerPayload {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range e.Withdrawals {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxWithdrawalRequestsPerPayload,
+		)
+	}
+
+	// Field (2) 'Consolidations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Consolidations))
+		if num > constants.MaxConsolidationRequestsPerPayload {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range e.Consolidations {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxConsolidationRequestsPerPayload,
+		)
+	}
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the ExecutionRequests object.
+func (e *ExecutionRequests) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(e)
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// generateExecutionRequests generates execution requests for testing
+// purposes.
+func generateExecutionRequests() *types.ExecutionRequests {
+	return &types.ExecutionRequests{
+		Deposits: []*types.DepositRequest{
+			{
+				Pubkey:    crypto.BLSPubkey{1},
+				Amount:    math.Gwei(32e9),
+				Signature: crypto.BLSSignature{2},
+				Index:     7,
+			},
+		},
+		Withdrawals: []*types.WithdrawalRequest{
+			{
+				SourceAddress:   common.ExecutionAddress{3},
+				ValidatorPubkey: crypto.BLSPubkey{4},
+				Amount:          math.Gwei(1e9),
+			},
+			{
+				SourceAddress:   common.ExecutionAddress{5},
+				ValidatorPubkey: crypto.BLSPubkey{6},
+			},
+		},
+	}
+}
+
+func TestExecutionRequests_MarshalUnmarshalSSZ(t *testing.T) {
+	requests := generateExecutionRequests()
+
+	bz, err := requests.MarshalSSZ()
+	require.NoError(t, err)
+
+	unmarshalled := new(types.ExecutionRequests)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+	require.Equal(t, requests.Deposits, unmarshalled.Deposits)
+	require.Equal(t, requests.Withdrawals, unmarshalled.Withdrawals)
+	require.Empty(t, unmarshalled.Consolidations)
+}
+
+func TestExecutionRequests_HashTreeRoot(t *testing.T) {
+	requests := generateExecutionRequests()
+
+	tree, err := requests.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(requests.HashTreeRoot()), [32]byte(tree.Hash()))
+}
+
+func TestExecutionRequests_List(t *testing.T) {
+	requests := generateExecutionRequests()
+
+	list, err := requests.GetExecutionRequestsList()
+	require.NoError(t, err)
+
+	// The empty consolidation requests are omitted.
+	require.Len(t, list, 2)
+	require.Equal(t, types.DepositRequestType, list[0][0])
+	require.Len(t, list[0], 1+types.DepositRequestSize)
+	require.Equal(t, types.WithdrawalRequestType, list[1][0])
+	require.Len(t, list[1], 1+2*types.WithdrawalRequestSize)
+
+	decoded, err := types.DecodeExecutionRequestsList(list)
+	require.NoError(t, err)
+	require.Equal(t, requests.Deposits, decoded.Deposits)
+	require.Equal(t, requests.Withdrawals, decoded.Withdrawals)
+
+	deposits := decoded.GetDeposits()
+	require.Len(t, deposits, 1)
+	require.Equal(t, requests.Deposits[0].Pubkey, deposits[0].Pubkey)
+	require.Equal(t, requests.Deposits[0].Index, deposits[0].Index)
+}
+
+func TestDecodeExecutionRequestsList_Invalid(t *testing.T) {
+	requests := generateExecutionRequests()
+	list, err := requests.GetExecutionRequestsList()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		list []bytes.Bytes
+	}{
+		{
+			name: "out of order",
+			list: []bytes.Bytes{list[1], list[0]},
+		},
+		{
+			name: "duplicate type",
+			list: []bytes.Bytes{list[0], list[0]},
+		},
+		{
+			name: "empty entry",
+			list: []bytes.Bytes{{types.DepositRequestType}},
+		},
+		{
+			name: "unknown type",
+			list: []bytes.Bytes{{0x03, 0x01}},
+		},
+		{
+			name: "truncated request",
+			list: []bytes.Bytes{list[0][:len(list[0])-1]},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := types.DecodeExecutionRequestsList(tt.list)
+			require.ErrorIs(t, err, types.ErrInvalidExecutionRequests)
+		})
+	}
+}
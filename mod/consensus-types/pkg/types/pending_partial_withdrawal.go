@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// PendingPartialWithdrawalSize is the size of the SSZ encoding of a
+// PendingPartialWithdrawal.
+const PendingPartialWithdrawalSize = 24 // 8 + 8 + 8
+
+// Compile-time assertions to ensure PendingPartialWithdrawal implements the
+// necessary interfaces.
+var (
+	_ ssz.StaticObject                    = (*PendingPartialWithdrawal)(nil)
+	_ constraints.SSZMarshallableRootable = (*PendingPartialWithdrawal)(nil)
+)
+
+// PendingPartialWithdrawal as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#pendingpartialwithdrawal
+//
+//nolint:lll
+type PendingPartialWithdrawal struct {
+	// Index is the index of the withdrawing validator.
+	Index math.ValidatorIndex `json:"index"`
+	// Amount is the amount to withdraw.
+	Amount math.Gwei `json:"amount"`
+	// WithdrawableEpoch is the earliest epoch at which the withdrawal may be
+	// processed.
+	WithdrawableEpoch math.Epoch `json:"withdrawable_epoch"`
+}
+
+// New creates a new PendingPartialWithdrawal.
+func (*PendingPartialWithdrawal) New(
+	index math.ValidatorIndex,
+	amount math.Gwei,
+	withdrawableEpoch math.Epoch,
+) *PendingPartialWithdrawal {
+	return &PendingPartialWithdrawal{
+		Index:             index,
+		Amount:            amount,
+		WithdrawableEpoch: withdrawableEpoch,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the PendingPartialWithdrawal object in SSZ
+// encoding.
+func (*PendingPartialWithdrawal) SizeSSZ() uint32 {
+	return PendingPartialWithdrawalSize
+}
+
+// DefineSSZ defines the SSZ encoding for the PendingPartialWithdrawal object.
+func (w *PendingPartialWithdrawal) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &w.Index)
+	ssz.DefineUint64(codec, &w.Amount)
+	ssz.DefineUint64(codec, &w.WithdrawableEpoch)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the PendingPartialWithdrawal
+// object.
+func (w *PendingPartialWithdrawal) HashTreeRoot() common.Root {
+	return ssz.HashSequential(w)
+}
+
+// MarshalSSZ marshals the PendingPartialWithdrawal object to SSZ format.
+func (w *PendingPartialWithdrawal) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, w.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, w)
+}
+
+// UnmarshalSSZ unmarshals the PendingPartialWithdrawal object from SSZ format.
+func (w *PendingPartialWithdrawal) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, w)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the PendingPartialWithdrawal object into a
+// pre-allocated byte slice.
+func (w *PendingPartialWithdrawal) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := w.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, bz...), nil
+}
+
+// HashTreeRootWith ssz hashes the PendingPartialWithdrawal object with a
+// hasher.
+func (w *PendingPartialWithdrawal) HashTreeRootWith(
+	hh fastssz.HashWalker,
+) error {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(uint64(w.Index))
+
+	// Field (1) 'Amount'
+	hh.PutUint64(uint64(w.Amount))
+
+	// Field (2) 'WithdrawableEpoch'
+	hh.PutUint64(uint64(w.WithdrawableEpoch))
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the PendingPartialWithdrawal object.
+func (w *PendingPartialWithdrawal) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(w)
+}
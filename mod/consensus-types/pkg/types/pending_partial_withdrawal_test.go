@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingPartialWithdrawal_MarshalUnmarshalSSZ(t *testing.T) {
+	original := new(types.PendingPartialWithdrawal).New(3, 1e9, 10)
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, types.PendingPartialWithdrawalSize)
+
+	unmarshalled := new(types.PendingPartialWithdrawal)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, original, unmarshalled)
+}
+
+func TestPendingPartialWithdrawal_UnmarshalSSZ_ErrSize(t *testing.T) {
+	err := new(types.PendingPartialWithdrawal).UnmarshalSSZ(make([]byte, 10))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestPendingPartialWithdrawal_HashTreeRoot(t *testing.T) {
+	withdrawal := new(types.PendingPartialWithdrawal).New(3, 1e9, 10)
+
+	// The fastssz tree must agree with the karalabe/ssz hasher.
+	tree, err := withdrawal.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, withdrawal.HashTreeRoot(), common.Root(tree.Hash()))
+}
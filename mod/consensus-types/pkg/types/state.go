@@ -43,34 +43,41 @@ type BeaconState[
 	B, E, P, F, V any,
 ] struct {
 	// Versioning
-	GenesisValidatorsRoot common.Root
-	Slot                  math.Slot
-	Fork                  ForkT
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+	Slot                  math.Slot   `json:"slot"`
+	Fork                  ForkT       `json:"fork"`
 
 	// History
-	LatestBlockHeader BeaconBlockHeaderT
-	BlockRoots        []common.Root
-	StateRoots        []common.Root
+	LatestBlockHeader BeaconBlockHeaderT `json:"latest_block_header"`
+	BlockRoots        []common.Root      `json:"block_roots"`
+	StateRoots        []common.Root      `json:"state_roots"`
 
 	// Eth1
-	Eth1Data                     Eth1DataT
-	Eth1DepositIndex             uint64
-	LatestExecutionPayloadHeader ExecutionPayloadHeaderT
+	Eth1Data                     Eth1DataT               `json:"eth1_data"`
+	Eth1DepositIndex             uint64                  `json:"eth1_deposit_index"`
+	LatestExecutionPayloadHeader ExecutionPayloadHeaderT `json:"latest_execution_payload_header"`
 
 	// Registry
-	Validators []ValidatorT
-	Balances   []uint64
+	Validators []ValidatorT `json:"validators"`
+	Balances   []uint64     `json:"balances"`
 
 	// Randomness
-	RandaoMixes []common.Bytes32
+	RandaoMixes []common.Bytes32 `json:"randao_mixes"`
 
 	// Withdrawals
-	NextWithdrawalIndex          uint64
-	NextWithdrawalValidatorIndex math.ValidatorIndex
+	NextWithdrawalIndex          uint64              `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex math.ValidatorIndex `json:"next_withdrawal_validator_index"`
 
 	// Slashing
-	Slashings     []uint64
-	TotalSlashing math.Gwei
+	Slashings     []uint64  `json:"slashings"`
+	TotalSlashing math.Gwei `json:"total_slashing"`
+
+	// Participation
+	EpochParticipation []uint64 `json:"epoch_participation"`
+	InactivityScores   []uint64 `json:"inactivity_scores"`
+
+	// Pending withdrawals
+	PendingPartialWithdrawals []*PendingPartialWithdrawal `json:"pending_partial_withdrawals"`
 }
 
 // New creates a new BeaconState.
@@ -99,6 +106,9 @@ func (st *BeaconState[
 	nextWithdrawalValidatorIndex math.ValidatorIndex,
 	slashings []uint64,
 	totalSlashing math.Gwei,
+	epochParticipation []uint64,
+	inactivityScores []uint64,
+	pendingPartialWithdrawals []*PendingPartialWithdrawal,
 ) (*BeaconState[
 	BeaconBlockHeaderT,
 	Eth1DataT,
@@ -131,6 +141,9 @@ func (st *BeaconState[
 		NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
 		Slashings:                    slashings,
 		TotalSlashing:                totalSlashing,
+		EpochParticipation:           epochParticipation,
+		InactivityScores:             inactivityScores,
+		PendingPartialWithdrawals:    pendingPartialWithdrawals,
 	}, nil
 }
 
@@ -142,7 +155,7 @@ func (st *BeaconState[
 func (st *BeaconState[
 	_, _, _, _, _, _, _, _, _, _,
 ]) SizeSSZ(fixed bool) uint32 {
-	var size uint32 = 300
+	var size uint32 = 312
 
 	if fixed {
 		return size
@@ -156,6 +169,9 @@ func (st *BeaconState[
 	size += ssz.SizeSliceOfUint64s(st.Balances)
 	size += ssz.SizeSliceOfStaticBytes(st.RandaoMixes)
 	size += ssz.SizeSliceOfUint64s(st.Slashings)
+	size += ssz.SizeSliceOfUint64s(st.EpochParticipation)
+	size += ssz.SizeSliceOfUint64s(st.InactivityScores)
+	size += ssz.SizeSliceOfStaticObjects(st.PendingPartialWithdrawals)
 
 	return size
 }
@@ -196,6 +212,15 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sOffset(codec, &st.Slashings, 1099511627776)
 	ssz.DefineUint64(codec, (*uint64)(&st.TotalSlashing))
 
+	// Participation
+	ssz.DefineSliceOfUint64sOffset(codec, &st.EpochParticipation, 1099511627776)
+	ssz.DefineSliceOfUint64sOffset(codec, &st.InactivityScores, 1099511627776)
+
+	// Pending withdrawals
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &st.PendingPartialWithdrawals, 134217728,
+	)
+
 	// Dynamic content
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.BlockRoots, 8192)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.StateRoots, 8192)
@@ -204,6 +229,11 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sContent(codec, &st.Balances, 1099511627776)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.RandaoMixes, 65536)
 	ssz.DefineSliceOfUint64sContent(codec, &st.Slashings, 1099511627776)
+	ssz.DefineSliceOfUint64sContent(codec, &st.EpochParticipation, 1099511627776)
+	ssz.DefineSliceOfUint64sContent(codec, &st.InactivityScores, 1099511627776)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &st.PendingPartialWithdrawals, 134217728,
+	)
 }
 
 // MarshalSSZ marshals the BeaconState into SSZ format.
@@ -391,6 +421,59 @@ func (st *BeaconState[
 	// Field (15) 'TotalSlashing'
 	hh.PutUint64(uint64(st.TotalSlashing))
 
+	// Field (16) 'EpochParticipation'
+	if size := len(st.EpochParticipation); size > 1099511627776 {
+		return fastssz.ErrListTooBigFn(
+			"BeaconState.EpochParticipation",
+			size,
+			1099511627776,
+		)
+	}
+	subIndx = hh.Index()
+	for _, i := range st.EpochParticipation {
+		hh.AppendUint64(i)
+	}
+	hh.FillUpTo32()
+	numItems = uint64(len(st.EpochParticipation))
+	hh.MerkleizeWithMixin(
+		subIndx,
+		numItems,
+		fastssz.CalculateLimit(1099511627776, numItems, 8),
+	)
+
+	// Field (17) 'InactivityScores'
+	if size := len(st.InactivityScores); size > 1099511627776 {
+		return fastssz.ErrListTooBigFn(
+			"BeaconState.InactivityScores",
+			size,
+			1099511627776,
+		)
+	}
+	subIndx = hh.Index()
+	for _, i := range st.InactivityScores {
+		hh.AppendUint64(i)
+	}
+	hh.FillUpTo32()
+	numItems = uint64(len(st.InactivityScores))
+	hh.MerkleizeWithMixin(
+		subIndx,
+		numItems,
+		fastssz.CalculateLimit(1099511627776, numItems, 8),
+	)
+
+	// Field (18) 'PendingPartialWithdrawals'
+	subIndx = hh.Index()
+	num = uint64(len(st.PendingPartialWithdrawals))
+	if num > 134217728 {
+		return fastssz.ErrIncorrectListSize
+	}
+	for _, elem := range st.PendingPartialWithdrawals {
+		if err := elem.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+	hh.MerkleizeWithMixin(subIndx, num, 134217728)
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -133,7 +133,12 @@ func generateValidBeaconState() *types.BeaconState[
 			DepositCount: 1000,
 			BlockHash:    [32]byte{0x41, 0x42, 0x43},
 		},
-		Eth1DepositIndex: 100,
+		Eth1DepositIndex:   100,
+		EpochParticipation: []uint64{3, 0},
+		InactivityScores:   []uint64{0, 4},
+		PendingPartialWithdrawals: []*types.PendingPartialWithdrawal{
+			{Index: 0, Amount: 1000000000, WithdrawableEpoch: 9},
+		},
 	}
 }
 
@@ -268,6 +268,11 @@ func (v Validator) IsSlashed() bool {
 	return v.Slashed
 }
 
+// SetSlashed sets whether the validator has been slashed.
+func (v *Validator) SetSlashed(slashed bool) {
+	v.Slashed = slashed
+}
+
 // IsFullyWithdrawable as defined in the Ethereum 2.0 specification:
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#is_fully_withdrawable_validator
 //
@@ -322,3 +327,40 @@ func (v Validator) GetWithdrawableEpoch() math.Epoch {
 func (v Validator) GetWithdrawalCredentials() WithdrawalCredentials {
 	return v.WithdrawalCredentials
 }
+
+// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+func (v *Validator) SetWithdrawableEpoch(epoch math.Epoch) {
+	v.WithdrawableEpoch = epoch
+}
+
+// GetActivationEligibilityEpoch returns the epoch in which the validator
+// became eligible for activation.
+func (v Validator) GetActivationEligibilityEpoch() math.Epoch {
+	return v.ActivationEligibilityEpoch
+}
+
+// SetActivationEligibilityEpoch sets the epoch in which the validator became
+// eligible for activation.
+func (v *Validator) SetActivationEligibilityEpoch(epoch math.Epoch) {
+	v.ActivationEligibilityEpoch = epoch
+}
+
+// GetActivationEpoch returns the epoch in which the validator activated.
+func (v Validator) GetActivationEpoch() math.Epoch {
+	return v.ActivationEpoch
+}
+
+// SetActivationEpoch sets the epoch in which the validator activated.
+func (v *Validator) SetActivationEpoch(epoch math.Epoch) {
+	v.ActivationEpoch = epoch
+}
+
+// GetExitEpoch returns the epoch in which the validator exited.
+func (v Validator) GetExitEpoch() math.Epoch {
+	return v.ExitEpoch
+}
+
+// SetExitEpoch sets the epoch in which the validator exits.
+func (v *Validator) SetExitEpoch(epoch math.Epoch) {
+	v.ExitEpoch = epoch
+}
@@ -886,6 +886,14 @@ func TestValidator_IsSlashed(t *testing.T) {
 	}
 }
 
+func TestValidator_SetSlashed(t *testing.T) {
+	validator := &types.Validator{}
+	validator.SetSlashed(true)
+	require.True(t, validator.IsSlashed())
+	validator.SetSlashed(false)
+	require.False(t, validator.IsSlashed())
+}
+
 func TestValidator_New(t *testing.T) {
 	tests := []struct {
 		name                      string
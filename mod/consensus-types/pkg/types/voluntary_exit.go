@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// VoluntaryExitSize is the size of the SSZ encoding of a VoluntaryExit.
+	VoluntaryExitSize = 16 // 8 + 8
+
+	// SignedVoluntaryExitSize is the size of the SSZ encoding of a
+	// SignedVoluntaryExit.
+	SignedVoluntaryExitSize = VoluntaryExitSize + 96
+)
+
+// Compile-time assertions to ensure the exit types implement the necessary
+// interfaces.
+var (
+	_ ssz.StaticObject                    = (*VoluntaryExit)(nil)
+	_ constraints.SSZMarshallableRootable = (*VoluntaryExit)(nil)
+	_ ssz.StaticObject                    = (*SignedVoluntaryExit)(nil)
+	_ constraints.SSZMarshallableRootable = (*SignedVoluntaryExit)(nil)
+)
+
+// VoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntaryexit
+//
+//nolint:lll
+type VoluntaryExit struct {
+	// Epoch is the earliest epoch at which the exit may be processed.
+	Epoch math.Epoch `json:"epoch"`
+	// ValidatorIndex is the index of the exiting validator.
+	ValidatorIndex math.ValidatorIndex `json:"validator_index"`
+}
+
+// SignedVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedvoluntaryexit
+//
+//nolint:lll
+type SignedVoluntaryExit struct {
+	// Message is the voluntary exit being signed over.
+	Message *VoluntaryExit `json:"message"`
+	// Signature is the signature of the exiting validator over the message.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// CreateAndSignVoluntaryExit constructs a voluntary exit for the validator at
+// the given index and signs it with the given signer.
+func CreateAndSignVoluntaryExit(
+	forkData *ForkData,
+	domainType common.DomainType,
+	signer crypto.BLSSigner,
+	epoch math.Epoch,
+	validatorIndex math.ValidatorIndex,
+) (*SignedVoluntaryExit, error) {
+	exit := &VoluntaryExit{
+		Epoch:          epoch,
+		ValidatorIndex: validatorIndex,
+	}
+	signingRoot := ComputeSigningRoot(
+		exit, forkData.ComputeDomain(domainType),
+	)
+	signature, err := signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedVoluntaryExit{
+		Message:   exit,
+		Signature: signature,
+	}, nil
+}
+
+// Empty creates an empty SignedVoluntaryExit.
+func (*SignedVoluntaryExit) Empty() *SignedVoluntaryExit {
+	return &SignedVoluntaryExit{Message: new(VoluntaryExit)}
+}
+
+// VerifySignature verifies that the exit was signed by the given public key.
+func (e *SignedVoluntaryExit) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		e.Message, forkData.ComputeDomain(domainType),
+	)
+	if err := signatureVerificationFn(
+		pubkey, signingRoot[:], e.Signature,
+	); err != nil {
+		return errors.Join(err, ErrVoluntaryExitSignature)
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the VoluntaryExit object in SSZ encoding.
+func (*VoluntaryExit) SizeSSZ() uint32 {
+	return VoluntaryExitSize
+}
+
+// DefineSSZ defines the SSZ encoding for the VoluntaryExit object.
+func (e *VoluntaryExit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &e.Epoch)
+	ssz.DefineUint64(codec, &e.ValidatorIndex)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the VoluntaryExit object.
+func (e *VoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// MarshalSSZ marshals the VoluntaryExit object to SSZ format.
+func (e *VoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the VoluntaryExit object from SSZ format.
+func (e *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// SizeSSZ returns the size of the SignedVoluntaryExit object in SSZ encoding.
+func (*SignedVoluntaryExit) SizeSSZ() uint32 {
+	return SignedVoluntaryExitSize
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedVoluntaryExit object.
+func (e *SignedVoluntaryExit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &e.Message)
+	ssz.DefineStaticBytes(codec, &e.Signature)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the SignedVoluntaryExit
+// object.
+func (e *SignedVoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// MarshalSSZ marshals the SignedVoluntaryExit object to SSZ format.
+func (e *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the SignedVoluntaryExit object from SSZ format.
+func (e *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the VoluntaryExit object into a pre-allocated byte
+// slice.
+func (e *VoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := e.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, bz...), nil
+}
+
+// HashTreeRootWith ssz hashes the VoluntaryExit object with a hasher.
+func (e *VoluntaryExit) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(uint64(e.Epoch))
+
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(uint64(e.ValidatorIndex))
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the VoluntaryExit object.
+func (e *VoluntaryExit) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(e)
+}
+
+// MarshalSSZTo marshals the SignedVoluntaryExit object into a pre-allocated
+// byte slice.
+func (e *SignedVoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := e.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, bz...), nil
+}
+
+// HashTreeRootWith ssz hashes the SignedVoluntaryExit object with a hasher.
+func (e *SignedVoluntaryExit) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if e.Message == nil {
+		e.Message = new(VoluntaryExit)
+	}
+	if err := e.Message.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(e.Signature[:])
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the SignedVoluntaryExit object.
+func (e *SignedVoluntaryExit) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(e)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             Getters and Setters                            */
+/* -------------------------------------------------------------------------- */
+
+// GetEpoch returns the earliest epoch at which the exit may be processed.
+func (e *VoluntaryExit) GetEpoch() math.Epoch {
+	return e.Epoch
+}
+
+// GetValidatorIndex returns the index of the exiting validator.
+func (e *VoluntaryExit) GetValidatorIndex() math.ValidatorIndex {
+	return e.ValidatorIndex
+}
+
+// GetEpoch returns the earliest epoch at which the exit may be processed.
+func (e *SignedVoluntaryExit) GetEpoch() math.Epoch {
+	return e.Message.GetEpoch()
+}
+
+// GetValidatorIndex returns the index of the exiting validator.
+func (e *SignedVoluntaryExit) GetValidatorIndex() math.ValidatorIndex {
+	return e.Message.GetValidatorIndex()
+}
+
+// GetSignature returns the signature over the exit message.
+func (e *SignedVoluntaryExit) GetSignature() crypto.BLSSignature {
+	return e.Signature
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func generateSignedVoluntaryExit() *types.SignedVoluntaryExit {
+	return &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{
+			Epoch:          math.Epoch(10),
+			ValidatorIndex: math.ValidatorIndex(3),
+		},
+		Signature: crypto.BLSSignature{1, 2, 3},
+	}
+}
+
+func TestSignedVoluntaryExit_MarshalUnmarshalSSZ(t *testing.T) {
+	original := generateSignedVoluntaryExit()
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, int(original.SizeSSZ()))
+
+	unmarshalled := new(types.SignedVoluntaryExit).Empty()
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, original, unmarshalled)
+}
+
+func TestSignedVoluntaryExit_UnmarshalSSZ_ErrSize(t *testing.T) {
+	unmarshalled := new(types.SignedVoluntaryExit).Empty()
+	err := unmarshalled.UnmarshalSSZ(make([]byte, 10))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestSignedVoluntaryExit_HashTreeRoot(t *testing.T) {
+	exit := generateSignedVoluntaryExit()
+
+	// The fastssz tree must agree with the karalabe/ssz hasher.
+	tree, err := exit.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, exit.HashTreeRoot(), common.Root(tree.Hash()))
+}
+
+func TestSignedVoluntaryExit_Getters(t *testing.T) {
+	exit := generateSignedVoluntaryExit()
+	require.Equal(t, math.Epoch(10), exit.GetEpoch())
+	require.Equal(t, math.ValidatorIndex(3), exit.GetValidatorIndex())
+	require.Equal(t, crypto.BLSSignature{1, 2, 3}, exit.GetSignature())
+}
+
+func TestCreateAndSignVoluntaryExit(t *testing.T) {
+	forkData := &types.ForkData{
+		CurrentVersion:        common.Version{0x04, 0x00, 0x00, 0x00},
+		GenesisValidatorsRoot: common.Root{0x01},
+	}
+	domainType := common.DomainType{0x04, 0x00, 0x00, 0x00}
+	signature := crypto.BLSSignature{4, 5, 6}
+
+	signer := &mocks.BLSSigner{}
+	signer.On("Sign", mock.Anything).Return(signature, nil)
+
+	exit, err := types.CreateAndSignVoluntaryExit(
+		forkData, domainType, signer, math.Epoch(7), math.ValidatorIndex(2),
+	)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(7), exit.GetEpoch())
+	require.Equal(t, math.ValidatorIndex(2), exit.GetValidatorIndex())
+	require.Equal(t, signature, exit.GetSignature())
+
+	// The signer must have been asked to sign the exit's signing root.
+	expectedRoot := types.ComputeSigningRoot(
+		exit.Message, forkData.ComputeDomain(domainType),
+	)
+	signer.AssertCalled(t, "Sign", expectedRoot[:])
+
+	var verifiedRoot []byte
+	require.NoError(t, exit.VerifySignature(
+		forkData, domainType, crypto.BLSPubkey{},
+		func(_ crypto.BLSPubkey, msg []byte, sig crypto.BLSSignature) error {
+			verifiedRoot = msg
+			require.Equal(t, signature, sig)
+			return nil
+		},
+	))
+	require.Equal(t, expectedRoot[:], verifiedRoot)
+}
+
+func TestSignedVoluntaryExit_VerifySignature_Error(t *testing.T) {
+	exit := generateSignedVoluntaryExit()
+	err := exit.VerifySignature(
+		&types.ForkData{},
+		common.DomainType{0x04, 0x00, 0x00, 0x00},
+		crypto.BLSPubkey{},
+		func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error {
+			return errors.New("signature verification failed")
+		},
+	)
+	require.ErrorIs(t, err, types.ErrVoluntaryExitSignature)
+}
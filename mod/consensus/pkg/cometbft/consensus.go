@@ -102,7 +102,14 @@ func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) ProcessProposal(
 	ctx sdk.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
-	resp, err := c.Middleware.ProcessProposal(ctx, req)
+	slotData, err := c.convertProcessProposalToSlotData(
+		ctx,
+		req,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Middleware.ProcessProposal(ctx, req, slotData)
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -40,9 +41,56 @@ func (c *ConsensusEngine[
 	var t SlotDataT
 
 	// Get the attestation data from the votes.
+	votes := make([]v1.VoteInfo, len(req.LocalLastCommit.Votes))
+	for i, vote := range req.LocalLastCommit.Votes {
+		votes[i] = v1.VoteInfo{
+			Validator:   vote.Validator,
+			BlockIdFlag: vote.BlockIdFlag,
+		}
+	}
 	attestationData, err := c.attestationsFromVotes(
 		ctx,
-		req.LocalLastCommit.Votes,
+		votes,
+		//#nosec:G701 // safe.
+		math.Slot(req.Height),
+	)
+	if err != nil {
+		return t, err
+	}
+
+	// Get the slashing info from the misbehaviors.
+	slashingInfo, err := c.slashingInfoFromMisbehaviors(
+		ctx,
+		req.Misbehavior,
+	)
+	if err != nil {
+		return t, err
+	}
+
+	// Create the slot data.
+	t = t.New(
+		math.U64(req.Height),
+		attestationData,
+		slashingInfo,
+	)
+	return t, nil
+}
+
+// convertProcessProposalToSlotData converts a process proposal request to
+// the slot data the proposed block is expected to carry.
+func (c *ConsensusEngine[
+	_, _, _, SlotDataT, _, _,
+]) convertProcessProposalToSlotData(
+	ctx sdk.Context,
+	req *cmtabci.ProcessProposalRequest,
+) (SlotDataT, error) {
+	var t SlotDataT
+
+	// Get the attestation data from the votes of the proposed last commit,
+	// which the proposer built its attestations from.
+	attestationData, err := c.attestationsFromVotes(
+		ctx,
+		req.ProposedLastCommit.Votes,
 		//#nosec:G701 // safe.
 		math.Slot(req.Height),
 	)
@@ -73,15 +121,21 @@ func (c *ConsensusEngine[
 	AttestationDataT, _, _, _, _, _,
 ]) attestationsFromVotes(
 	ctx sdk.Context,
-	votes []v1.ExtendedVoteInfo,
+	votes []v1.VoteInfo,
 	slot math.Slot,
 ) ([]AttestationDataT, error) {
 	var err error
 	var index math.U64
-	attestations := make([]AttestationDataT, len(votes))
+	attestations := make([]AttestationDataT, 0, len(votes))
 	st := c.sb.StateFromContext(ctx)
 	root := st.HashTreeRoot()
-	for i, vote := range votes {
+	for _, vote := range votes {
+		// Only commit votes attest to the block. Absent and nil votes are
+		// skipped so that those validators are not credited.
+		if vote.BlockIdFlag != cmttypes.BlockIDFlagCommit {
+			continue
+		}
+
 		index, err = st.ValidatorIndexByCometBFTAddress(vote.Validator.Address)
 		if err != nil {
 			return nil, err
@@ -93,7 +147,7 @@ func (c *ConsensusEngine[
 			index,
 			root,
 		)
-		attestations[i] = t
+		attestations = append(attestations, t)
 	}
 
 	// Attestations are sorted by index.
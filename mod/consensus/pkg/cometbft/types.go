@@ -58,7 +58,7 @@ type Middleware[
 	) (transition.ValidatorUpdates, error)
 	PrepareProposal(context.Context, SlotDataT) ([]byte, []byte, error)
 	ProcessProposal(
-		ctx context.Context, req proto.Message,
+		ctx context.Context, req proto.Message, slotData SlotDataT,
 	) (proto.Message, error)
 	FinalizeBlock(
 		ctx context.Context,
@@ -139,7 +139,7 @@ func (f *SidecarFactory[BeaconBlockT, BeaconBlockBodyT]) BuildBlockBodyProof(
 	defer f.metrics.measureBuildBlockBodyProofDuration(startTime)
 	tree, err := merkle.NewTreeWithMaxLeaves[common.Root](
 		body.GetTopLevelRoots(),
-		body.Length()-1,
+		body.Length(),
 	)
 	if err != nil {
 		return nil, err
@@ -24,13 +24,17 @@ import (
 	"context"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 )
 
 type Service[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
-	BeaconBlockBodyT any,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
 	BlobSidecarsT BlobSidecar,
 	//nolint:lll // formatter.
 	EventPublisherSubscriberT EventPublisherSubscriber[*asynctypes.Event[BlobSidecarsT]],
@@ -41,6 +45,11 @@ type Service[
 		AvailabilityStoreT, BeaconBlockBodyT,
 		BlobSidecarsT, ExecutionPayloadT,
 	]
+	// blobFetcher fetches blobs from the mempool of the execution client.
+	blobFetcher BlobFetcher
+	// sidecarFactory builds the sidecars of blobs fetched from the execution
+	// client.
+	sidecarFactory SidecarFactory[BeaconBlockT, BlobSidecarsT]
 	sidecarsBroker EventPublisherSubscriberT
 	logger         log.Logger[any]
 }
@@ -50,7 +59,8 @@ func NewService[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
 	],
-	BeaconBlockBodyT any,
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
 	BlobSidecarsT BlobSidecar,
 	//nolint:lll // formatter.
 	EventPublisherSubscriberT EventPublisherSubscriber[*asynctypes.Event[BlobSidecarsT]],
@@ -61,30 +71,34 @@ func NewService[
 		AvailabilityStoreT, BeaconBlockBodyT,
 		BlobSidecarsT, ExecutionPayloadT,
 	],
+	blobFetcher BlobFetcher,
+	sidecarFactory SidecarFactory[BeaconBlockT, BlobSidecarsT],
 	sidecarsBroker EventPublisherSubscriberT,
 	logger log.Logger[any],
 ) *Service[
-	AvailabilityStoreT, BeaconBlockBodyT,
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
 	BlobSidecarsT, EventPublisherSubscriberT, ExecutionPayloadT,
 ] {
 	return &Service[
-		AvailabilityStoreT, BeaconBlockBodyT,
+		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
 		BlobSidecarsT, EventPublisherSubscriberT, ExecutionPayloadT,
 	]{
 		avs:            avs,
 		bp:             bp,
+		blobFetcher:    blobFetcher,
+		sidecarFactory: sidecarFactory,
 		sidecarsBroker: sidecarsBroker,
 		logger:         logger,
 	}
 }
 
 // Name returns the name of the service.
-func (s *Service[_, _, _, _, _]) Name() string {
+func (s *Service[_, _, _, _, _, _]) Name() string {
 	return "da"
 }
 
 // Start starts the service.
-func (s *Service[_, _, _, _, _]) Start(ctx context.Context) error {
+func (s *Service[_, _, _, _, _, _]) Start(ctx context.Context) error {
 	subSidecarsCh, err := s.sidecarsBroker.Subscribe()
 	if err != nil {
 		return err
@@ -94,7 +108,7 @@ func (s *Service[_, _, _, _, _]) Start(ctx context.Context) error {
 }
 
 // start starts the service.
-func (s *Service[_, _, BlobSidecarsT, _, _]) start(
+func (s *Service[_, _, _, BlobSidecarsT, _, _]) start(
 	ctx context.Context,
 	sidecarsCh chan *asynctypes.Event[BlobSidecarsT],
 ) {
@@ -116,7 +130,7 @@ func (s *Service[_, _, BlobSidecarsT, _, _]) start(
 // handleBlobSidecarsProcessRequest handles the BlobSidecarsProcessRequest
 // event.
 // It processes the sidecars and publishes a BlobSidecarsProcessed event.
-func (s *Service[_, _, BlobSidecarsT, _, _]) handleBlobSidecarsProcessRequest(
+func (s *Service[_, _, _, BlobSidecarsT, _, _]) handleBlobSidecarsProcessRequest(
 	msg *asynctypes.Event[BlobSidecarsT],
 ) {
 	err := s.processSidecars(msg.Context(), msg.Data())
@@ -143,7 +157,7 @@ func (s *Service[_, _, BlobSidecarsT, _, _]) handleBlobSidecarsProcessRequest(
 
 // handleBlobSidecarsReceived handles the BlobSidecarsReceived event.
 // It receives the sidecars and publishes a BlobSidecarsProcessed event.
-func (s *Service[_, _, BlobSidecarsT, _, _]) handleBlobSidecarsReceived(
+func (s *Service[_, _, _, BlobSidecarsT, _, _]) handleBlobSidecarsReceived(
 	msg *asynctypes.Event[BlobSidecarsT],
 ) {
 	err := s.receiveSidecars(msg.Data())
@@ -169,7 +183,7 @@ func (s *Service[_, _, BlobSidecarsT, _, _]) handleBlobSidecarsReceived(
 }
 
 // ProcessSidecars processes the blob sidecars.
-func (s *Service[_, _, BlobSidecarsT, _, _]) processSidecars(
+func (s *Service[_, _, _, BlobSidecarsT, _, _]) processSidecars(
 	_ context.Context,
 	sidecars BlobSidecarsT,
 ) error {
@@ -182,7 +196,7 @@ func (s *Service[_, _, BlobSidecarsT, _, _]) processSidecars(
 }
 
 // VerifyIncomingBlobs receives blobs from the network and processes them.
-func (s *Service[_, _, BlobSidecarsT, _, _]) receiveSidecars(
+func (s *Service[_, _, _, BlobSidecarsT, _, _]) receiveSidecars(
 	sidecars BlobSidecarsT,
 ) error {
 	// If there are no blobs to verify, return early.
@@ -211,3 +225,58 @@ func (s *Service[_, _, BlobSidecarsT, _, _]) receiveSidecars(
 
 	return nil
 }
+
+// ReconstructSidecars rebuilds the sidecars of the given block from the blobs
+// in the mempool of the execution client and stores them, so that a block
+// whose sidecars are missing or late is not declared unavailable. The
+// sidecars are verified like the ones received from the network, and every
+// blob of the block must be in the mempool.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _,
+]) ReconstructSidecars(
+	ctx context.Context,
+	blk BeaconBlockT,
+) error {
+	body := blk.GetBody()
+	commitments := body.GetBlobKzgCommitments()
+	if len(commitments) == 0 ||
+		s.avs.IsDataAvailable(ctx, blk.GetSlot(), body) {
+		return nil
+	}
+
+	blobs, err := s.blobFetcher.GetBlobs(ctx, commitments.ToVersionedHashes())
+	if err != nil {
+		return err
+	}
+
+	bundle := &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{
+		Commitments: commitments,
+		Proofs:      make([]eip4844.KZGProof, len(blobs)),
+		Blobs:       make([]*eip4844.Blob, len(blobs)),
+	}
+	for i, blob := range blobs {
+		if blob == nil || blob.Blob == nil {
+			return errors.Wrapf(ErrBlobNotInMempool, "index: %d", i)
+		}
+		bundle.Proofs[i] = blob.Proof
+		bundle.Blobs[i] = blob.Blob
+	}
+
+	sidecars, err := s.sidecarFactory.BuildSidecars(blk, bundle)
+	if err != nil {
+		return err
+	}
+
+	if err = s.bp.VerifySidecars(sidecars); err != nil {
+		return err
+	}
+
+	s.logger.Info(
+		"Reconstructed blob sidecars from the execution client 🧩",
+		"slot", blk.GetSlot().Base10(),
+		"num_blobs", sidecars.Len(),
+	)
+	return s.bp.ProcessSidecars(s.avs, sidecars)
+}
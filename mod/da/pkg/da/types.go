@@ -23,6 +23,9 @@ package da
 import (
 	"context"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -30,11 +33,40 @@ import (
 // sidecars for specific blocks, as well as verifying sidecars that have already
 // been stored.
 type AvailabilityStore[BeaconBlockBodyT any, BlobSidecarsT any] interface {
+	// IsDataAvailable ensures that all blobs referenced in the block are
+	// securely stored before it returns without an error.
+	IsDataAvailable(context.Context, math.Slot, BeaconBlockBodyT) bool
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
 }
 
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	// GetSlot returns the slot of the beacon block.
+	GetSlot() math.Slot
+	// GetBody returns the body of the beacon block.
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockBody is the interface for a beacon block body.
+type BeaconBlockBody interface {
+	// GetBlobKzgCommitments returns the KZG commitments of the blobs of the
+	// block.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+}
+
+// BlobFetcher is the interface for fetching blobs from the mempool of the
+// execution client.
+type BlobFetcher interface {
+	// GetBlobs returns the blobs and proofs for the given versioned hashes,
+	// with a nil entry for every blob that is not in the mempool.
+	GetBlobs(
+		ctx context.Context,
+		versionedHashes []common.ExecutionHash,
+	) ([]*engineprimitives.BlobAndProofV1[eip4844.KZGProof, eip4844.Blob], error)
+}
+
 // BlobProcessor is the interface for the blobs processor.
 type BlobProcessor[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
@@ -62,6 +94,16 @@ type BlobSidecar interface {
 	IsNil() bool
 }
 
+// SidecarFactory is the interface for building the blob sidecars of a block.
+type SidecarFactory[BeaconBlockT any, BlobSidecarsT any] interface {
+	// BuildSidecars builds the sidecars of the given block from the blobs
+	// bundle, including the KZG inclusion proofs.
+	BuildSidecars(
+		blk BeaconBlockT,
+		bundle engineprimitives.BlobsBundle,
+	) (BlobSidecarsT, error)
+}
+
 // EventPublisher represents the event publisher interface.
 type EventPublisherSubscriber[T any] interface {
 	// PublishEvent publishes an event.
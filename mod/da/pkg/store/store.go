@@ -22,6 +22,7 @@ package store
 
 import (
 	"context"
+	"encoding/binary"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -106,7 +107,16 @@ func (s *Store[BeaconBlockT]) Persist(
 			if err != nil {
 				return err
 			}
-			return s.Set(slot.Unwrap(), sc.KzgCommitment[:], bz)
+			if err = s.Set(
+				slot.Unwrap(), sc.KzgCommitment[:], bz,
+			); err != nil {
+				return err
+			}
+			// Index the commitment by the position of the blob in the block
+			// so that sidecars can be looked up by index as well.
+			return s.Set(
+				slot.Unwrap(), indexKey(sc.Index), sc.KzgCommitment[:],
+			)
 		},
 	)...); err != nil {
 		return err
@@ -117,3 +127,65 @@ func (s *Store[BeaconBlockT]) Persist(
 	)
 	return nil
 }
+
+// GetBlobSidecars returns the sidecars stored for the given slot. If indices
+// is empty all the stored sidecars are returned, otherwise only the ones at
+// the requested indices. Indices that have no sidecar stored are skipped.
+func (s *Store[BeaconBlockT]) GetBlobSidecars(
+	slot math.Slot,
+	indices []uint64,
+) (*types.BlobSidecars, error) {
+	if len(indices) == 0 {
+		indices = make([]uint64, s.chainSpec.MaxBlobsPerBlock())
+		for i := range indices {
+			indices[i] = uint64(i)
+		}
+	}
+
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, 0, len(indices)),
+	}
+	for _, index := range indices {
+		sidecar, err := s.getBlobSidecar(slot, index)
+		if err != nil {
+			return nil, err
+		}
+		if sidecar != nil {
+			sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+		}
+	}
+	return sidecars, nil
+}
+
+// getBlobSidecar returns the sidecar stored for the given slot and index, or
+// nil if there is none.
+func (s *Store[BeaconBlockT]) getBlobSidecar(
+	slot math.Slot,
+	index uint64,
+) (*types.BlobSidecar, error) {
+	key := indexKey(index)
+	ok, err := s.IndexDB.Has(slot.Unwrap(), key)
+	if err != nil || !ok {
+		return nil, err
+	}
+	commitment, err := s.IndexDB.Get(slot.Unwrap(), key)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := s.IndexDB.Get(slot.Unwrap(), commitment)
+	if err != nil {
+		return nil, err
+	}
+	sidecar := new(types.BlobSidecar)
+	if err = sidecar.UnmarshalSSZ(bz); err != nil {
+		return nil, err
+	}
+	return sidecar, nil
+}
+
+// indexKey returns the key under which the commitment of the blob at the
+// given index is stored. It can not collide with the commitments themselves,
+// which are 48 bytes long.
+func indexKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, index)
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// mockIndexDB is an in-memory IndexDB.
+type mockIndexDB map[string][]byte
+
+func (db mockIndexDB) Get(index uint64, key []byte) ([]byte, error) {
+	v, ok := db[fmt.Sprintf("%d/%x", index, key)]
+	if !ok {
+		return nil, fmt.Errorf("key not found: %x", key)
+	}
+	return v, nil
+}
+
+func (db mockIndexDB) Has(index uint64, key []byte) (bool, error) {
+	_, ok := db[fmt.Sprintf("%d/%x", index, key)]
+	return ok, nil
+}
+
+func (db mockIndexDB) Set(index uint64, key []byte, value []byte) error {
+	db[fmt.Sprintf("%d/%x", index, key)] = value
+	return nil
+}
+
+func TestGetBlobSidecars(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:                    32,
+			MinEpochsForBlobsSidecarsRequest: 5,
+			MaxBlobsPerBlock:                 6,
+		},
+	)
+	s := store.New[*ctypes.BeaconBlockBody](
+		mockIndexDB{}, noop.NewLogger[any](), cs,
+	)
+
+	slot := math.Slot(10)
+	sidecars := &types.BlobSidecars{}
+	for i := range uint64(3) {
+		sidecars.Sidecars = append(sidecars.Sidecars, types.BuildBlobSidecar(
+			math.U64(i),
+			&ctypes.BeaconBlockHeader{Slot: slot},
+			&eip4844.Blob{byte(i)},
+			eip4844.KZGCommitment{byte(i + 1)},
+			eip4844.KZGProof{},
+			make([]common.Root, 9),
+		))
+	}
+	require.NoError(t, s.Persist(slot, sidecars))
+
+	tests := []struct {
+		name     string
+		slot     math.Slot
+		indices  []uint64
+		expected []uint64
+	}{
+		{
+			name:     "All indices",
+			slot:     slot,
+			expected: []uint64{0, 1, 2},
+		},
+		{
+			name:     "Requested indices",
+			slot:     slot,
+			indices:  []uint64{2, 0},
+			expected: []uint64{2, 0},
+		},
+		{
+			name:     "Missing indices are skipped",
+			slot:     slot,
+			indices:  []uint64{1, 4},
+			expected: []uint64{1},
+		},
+		{
+			name:     "Empty slot",
+			slot:     slot + 1,
+			expected: []uint64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.GetBlobSidecars(tt.slot, tt.indices)
+			require.NoError(t, err)
+			require.Len(t, got.Sidecars, len(tt.expected))
+			for i, index := range tt.expected {
+				require.Equal(t, sidecars.Sidecars[index], got.Sidecars[i])
+			}
+		})
+	}
+}
@@ -38,4 +38,8 @@ var (
 	// inclusion.
 	ErrInvalidInclusionProof = errors.New(
 		"invalid KZG commitment inclusion proof")
+
+	// ErrInvalidSidecarsEncoding is returned when the SSZ encoding of
+	// sidecars is malformed.
+	ErrInvalidSidecarsEncoding = errors.New("invalid sidecars encoding")
 )
@@ -70,6 +70,38 @@ func BuildBlobSidecar(
 	}
 }
 
+// GetIndex returns the index of the blob in the block.
+func (b *BlobSidecar) GetIndex() uint64 {
+	return b.Index
+}
+
+// GetBlob returns the blob data.
+func (b *BlobSidecar) GetBlob() eip4844.Blob {
+	return b.Blob
+}
+
+// GetKzgCommitment returns the KZG commitment of the blob.
+func (b *BlobSidecar) GetKzgCommitment() eip4844.KZGCommitment {
+	return b.KzgCommitment
+}
+
+// GetKzgProof returns the KZG proof of the blob.
+func (b *BlobSidecar) GetKzgProof() eip4844.KZGProof {
+	return b.KzgProof
+}
+
+// GetBeaconBlockHeader returns the beacon block header of the block the blob
+// is included in.
+func (b *BlobSidecar) GetBeaconBlockHeader() *types.BeaconBlockHeader {
+	return b.BeaconBlockHeader
+}
+
+// GetInclusionProof returns the inclusion proof of the KZG commitment in the
+// beacon block body.
+func (b *BlobSidecar) GetInclusionProof() []common.Root {
+	return b.InclusionProof
+}
+
 // HasValidInclusionProof verifies the inclusion proof of the
 // blob in the beacon body.
 func (b *BlobSidecar) HasValidInclusionProof(
@@ -95,18 +127,38 @@ func (b *BlobSidecar) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineStaticBytes(codec, &b.KzgCommitment)
 	ssz.DefineStaticBytes(codec, &b.KzgProof)
 	ssz.DefineStaticObject(codec, &b.BeaconBlockHeader)
-	//nolint:mnd // depth of 8
-	ssz.DefineCheckedArrayOfStaticBytes(codec, &b.InclusionProof, 8)
+	ssz.DefineCheckedArrayOfStaticBytes(
+		codec, &b.InclusionProof, b.inclusionProofDepth(),
+	)
 }
 
 // SizeSSZ returns the size of the BlobSidecar object in SSZ encoding.
 func (b *BlobSidecar) SizeSSZ() uint32 {
+	return sidecarSize(b.inclusionProofDepth())
+}
+
+// inclusionProofDepth returns the depth of the inclusion proof of the
+// BlobSidecar, which depends on the fork of the block body it commits to.
+func (b *BlobSidecar) inclusionProofDepth() uint64 {
+	if b != nil &&
+		len(b.InclusionProof) == types.KZGInclusionProofDepthElectra {
+		return types.KZGInclusionProofDepthElectra
+	}
+	return types.KZGInclusionProofDepthDeneb
+}
+
+// sidecarSize returns the size of a BlobSidecar in SSZ encoding, given the
+// depth of its inclusion proof.
+//
+//nolint:mnd // field sizes.
+func sidecarSize(inclusionProofDepth uint64) uint32 {
 	return 8 + // Index
 		131072 + // Blob
 		48 + // KzgCommitment
 		48 + // KzgProof
 		112 + // BeaconBlockHeader
-		8*32 // InclusionProof
+		//#nosec:G701 // the depth is one of the fork constants.
+		uint32(inclusionProofDepth)*32 // InclusionProof
 }
 
 // MarshalSSZ marshals the BlobSidecar object to SSZ format.
@@ -117,6 +169,13 @@ func (b *BlobSidecar) MarshalSSZ() ([]byte, error) {
 
 // UnmarshalSSZ unmarshals the BlobSidecar object from SSZ format.
 func (b *BlobSidecar) UnmarshalSSZ(buf []byte) error {
+	// The depth of the inclusion proof is inferred from the size of the
+	// encoding.
+	depth := uint64(types.KZGInclusionProofDepthDeneb)
+	if len(buf) == int(sidecarSize(types.KZGInclusionProofDepthElectra)) {
+		depth = types.KZGInclusionProofDepthElectra
+	}
+	b.InclusionProof = make([]common.Root, depth)
 	return ssz.DecodeFromBytes(buf, b)
 }
 
@@ -22,11 +22,17 @@
 package types
 
 import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/karalabe/ssz"
 	"github.com/sourcegraph/conc/iter"
 )
 
+// maxBlobSidecars is the maximum number of sidecars in BlobSidecars.
+const maxBlobSidecars = 6
+
 // BlobSidecars is a slice of blob side cars to be included in the block.
 type BlobSidecars struct {
 	// Sidecars is a slice of blob side cars to be included in the block.
@@ -80,6 +86,11 @@ func (bs *BlobSidecars) VerifyInclusionProofs(
 	)...)
 }
 
+// GetSidecars returns the sidecars.
+func (bs *BlobSidecars) GetSidecars() []*BlobSidecar {
+	return bs.Sidecars
+}
+
 // Len returns the number of sidecars in the sidecar.
 func (bs *BlobSidecars) Len() int {
 	return len(bs.Sidecars)
@@ -87,8 +98,8 @@ func (bs *BlobSidecars) Len() int {
 
 // DefineSSZ defines the SSZ encoding for the BlobSidecars object.
 func (bs *BlobSidecars) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineSliceOfStaticObjectsOffset(codec, &bs.Sidecars, 6)
-	ssz.DefineSliceOfStaticObjectsContent(codec, &bs.Sidecars, 6)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &bs.Sidecars, maxBlobSidecars)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &bs.Sidecars, maxBlobSidecars)
 }
 
 // SizeSSZ returns the size of the BlobSidecars object in SSZ encoding.
@@ -112,6 +123,36 @@ func (bs *BlobSidecars) MarshalSSZTo(buf []byte) ([]byte, error) {
 }
 
 // UnmarshalSSZ unmarshals the BlobSidecars object from SSZ format.
+//
+// The size of a sidecar depends on the fork of the block it belongs to, so it
+// is inferred from the size of the encoding rather than decoded as a list of
+// static objects.
 func (bs *BlobSidecars) UnmarshalSSZ(buf []byte) error {
-	return ssz.DecodeFromBytes(buf, bs)
+	if len(buf) < 4 || binary.LittleEndian.Uint32(buf) != 4 {
+		return ErrInvalidSidecarsEncoding
+	}
+	content := buf[4:]
+	if len(content) == 0 {
+		bs.Sidecars = nil
+		return nil
+	}
+
+	size := int(sidecarSize(types.KZGInclusionProofDepthDeneb))
+	if len(content)%size != 0 {
+		size = int(sidecarSize(types.KZGInclusionProofDepthElectra))
+	}
+	if len(content)%size != 0 || len(content)/size > maxBlobSidecars {
+		return ErrInvalidSidecarsEncoding
+	}
+
+	bs.Sidecars = make([]*BlobSidecar, len(content)/size)
+	for i := range bs.Sidecars {
+		bs.Sidecars[i] = new(BlobSidecar)
+		if err := bs.Sidecars[i].UnmarshalSSZ(
+			content[i*size : (i+1)*size],
+		); err != nil {
+			return err
+		}
+	}
+	return nil
 }
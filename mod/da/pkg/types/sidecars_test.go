@@ -153,3 +153,37 @@ func TestValidateBlockRoots(t *testing.T) {
 		"Validating sidecar with invalid roots should produce an error",
 	)
 }
+
+func TestSidecarsMarshallingPerFork(t *testing.T) {
+	for _, depth := range []int{
+		ctypes.KZGInclusionProofDepthDeneb,
+		ctypes.KZGInclusionProofDepthElectra,
+	} {
+		sidecars := &types.BlobSidecars{}
+		for i := range 2 {
+			sidecar := types.BuildBlobSidecar(
+				math.U64(i),
+				&ctypes.BeaconBlockHeader{BodyRoot: [32]byte{1}},
+				&eip4844.Blob{},
+				eip4844.KZGCommitment{},
+				eip4844.KZGProof{},
+				make([]common.Root, depth),
+			)
+			sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+		}
+
+		bz, err := sidecars.MarshalSSZ()
+		require.NoError(t, err)
+		unmarshalled := &types.BlobSidecars{}
+		require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+		require.Equal(t, sidecars, unmarshalled)
+		require.Len(t, unmarshalled.Sidecars[0].InclusionProof, depth)
+	}
+
+	// An encoding which does not match any fork is rejected.
+	require.ErrorIs(
+		t,
+		(&types.BlobSidecars{}).UnmarshalSSZ([]byte{4, 0, 0, 0, 1}),
+		types.ErrInvalidSidecarsEncoding,
+	)
+}
@@ -48,3 +48,14 @@ func (b *BlobsBundleV1[C, P, B]) GetProofs() []P {
 func (b *BlobsBundleV1[C, P, B]) GetBlobs() []*B {
 	return b.Blobs
 }
+
+// BlobAndProofV1 represents a blob and its KZG proof, as returned by the
+// execution client for a versioned hash found in its mempool.
+type BlobAndProofV1[
+	P ~[48]byte, B ~[131072]byte,
+] struct {
+	// Blob is the data blob.
+	Blob *B `json:"blob"`
+	// Proof is the KZG proof of the blob.
+	Proof P `json:"proof"`
+}
@@ -21,9 +21,11 @@
 package engineprimitives_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,3 +45,19 @@ func TestBlobsBundleV1(t *testing.T) {
 	blobs := bundle.GetBlobs()
 	require.Equal(t, bundle.Blobs, blobs)
 }
+
+func TestBlobAndProofV1JSON(t *testing.T) {
+	blobs := []*engineprimitives.BlobAndProofV1[eip4844.KZGProof, eip4844.Blob]{
+		{Blob: &eip4844.Blob{1, 2, 3}, Proof: eip4844.KZGProof{4, 5, 6}},
+		nil,
+	}
+
+	bz, err := json.Marshal(blobs)
+	require.NoError(t, err)
+
+	var decoded []*engineprimitives.BlobAndProofV1[
+		eip4844.KZGProof, eip4844.Blob,
+	]
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, blobs, decoded)
+}
@@ -69,9 +69,15 @@ type NewPayloadRequest[
 	VersionedHashes []common.ExecutionHash
 	// ParentBeaconBlockRoot is the root of the parent beacon block.
 	ParentBeaconBlockRoot *common.Root
+	// ExecutionRequests is the list of requests triggered by the execution
+	// payload, as per EIP-7685. It is only sent from Electra onwards.
+	ExecutionRequests []bytes.Bytes
 	// Optimistic is a flag that indicates if the payload should be
 	// optimistically deemed valid. This is useful during syncing.
 	Optimistic bool
+	// ForkVersion is the fork version that we
+	// are going to be submitting for.
+	ForkVersion uint32
 }
 
 // BuildNewPayloadRequest builds a new payload request.
@@ -111,13 +117,17 @@ func BuildNewPayloadRequest[
 	executionPayload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
 	optimistic bool,
+	forkVersion uint32,
 ) *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT] {
 	return &NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]{
 		ExecutionPayload:      executionPayload,
 		VersionedHashes:       versionedHashes,
 		ParentBeaconBlockRoot: parentBeaconBlockRoot,
+		ExecutionRequests:     executionRequests,
 		Optimistic:            optimistic,
+		ForkVersion:           forkVersion,
 	}
 }
 
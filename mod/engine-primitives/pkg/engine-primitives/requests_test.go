@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -101,20 +102,26 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 	executionPayload := MockExecutionPayload{}
 	var versionedHashes []common.ExecutionHash
 	parentBeaconBlockRoot := common.Root{}
+	executionRequests := []bytes.Bytes{{0x00, 0x01}}
 	optimistic := false
+	forkVersion := version.Electra
 
 	request := engineprimitives.BuildNewPayloadRequest(
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		executionRequests,
 		optimistic,
+		forkVersion,
 	)
 
 	require.NotNil(t, request)
 	require.Equal(t, executionPayload, request.ExecutionPayload)
 	require.Equal(t, versionedHashes, request.VersionedHashes)
 	require.Equal(t, &parentBeaconBlockRoot, request.ParentBeaconBlockRoot)
+	require.Equal(t, executionRequests, request.ExecutionRequests)
 	require.Equal(t, optimistic, request.Optimistic)
+	require.Equal(t, forkVersion, request.ForkVersion)
 }
 
 func TestBuildForkchoiceUpdateRequest(t *testing.T) {
@@ -155,7 +162,9 @@ func TestHasValidVersionedAndBlockHashesPayloadError(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
 		optimistic,
+		version.Deneb,
 	)
 
 	err := request.HasValidVersionedAndBlockHashes()
@@ -174,7 +183,9 @@ func TestHasValidVersionedAndBlockHashesMismatchedHashes(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
 		optimistic,
+		version.Deneb,
 	)
 
 	err := request.HasValidVersionedAndBlockHashes()
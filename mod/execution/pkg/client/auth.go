@@ -24,9 +24,12 @@ import (
 	"context"
 	"net/http"
 	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 )
 
-// jwtRefreshLoop refreshes the JWT token for the execution client.
+// jwtRefreshLoop refreshes the JWT token for the execution clients dialed
+// over HTTP(S).
 func (s *EngineClient[
 	_, _,
 ]) jwtRefreshLoop(
@@ -40,14 +43,39 @@ func (s *EngineClient[
 			ticker.Stop()
 			return
 		case <-ticker.C:
-			if err := s.dialExecutionRPCClient(ctx); err != nil {
-				s.logger.Error(
-					"Failed to refresh engine auth token",
-					"err",
-					err,
-				)
-			}
+			s.refreshJWT(ctx)
+		}
+	}
+}
+
+// refreshJWT redials the execution clients dialed over HTTP(S) with a freshly
+// signed JWT token.
+func (s *EngineClient[
+	_, _,
+]) refreshJWT(
+	ctx context.Context,
+) {
+	for _, b := range s.backends {
+		if !b.isHTTP() || b.jwtSecret == nil {
+			continue
+		}
+
+		client, err := s.dialExecutionRPCClient(ctx, b)
+		if err != nil {
+			s.logger.Error(
+				"Failed to refresh engine auth token",
+				"dial_url", b.url.String(),
+				"err", err,
+			)
+			continue
+		}
+
+		s.mu.Lock()
+		b.client = client
+		if b.index == s.active {
+			s.Eth1Client = client
 		}
+		s.mu.Unlock()
 	}
 }
 
@@ -55,11 +83,11 @@ func (s *EngineClient[
 // attached for authorization.
 func (s *EngineClient[
 	_, _,
-]) buildJWTHeader() (http.Header, error) {
+]) buildJWTHeader(secret *jwt.Secret) (http.Header, error) {
 	header := make(http.Header)
 
 	// Build the JWT token.
-	token, err := buildSignedJWT(s.jwtSecret)
+	token, err := buildSignedJWT(secret)
 	if err != nil {
 		s.logger.Error("Failed to build JWT token", "err", err)
 		return header, err
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+)
+
+// backendStatus is the health of an execution client as last observed by the
+// health check.
+type backendStatus uint8
+
+const (
+	// backendUnavailable indicates that the execution client cannot be
+	// reached or is on the wrong chain.
+	backendUnavailable backendStatus = iota
+	// backendSyncing indicates that the execution client is reachable but
+	// still syncing.
+	backendSyncing
+	// backendSynced indicates that the execution client is reachable and
+	// synced.
+	backendSynced
+)
+
+// String returns the string representation of the backend status.
+func (s backendStatus) String() string {
+	switch s {
+	case backendSyncing:
+		return "syncing"
+	case backendSynced:
+		return "synced"
+	default:
+		return "unavailable"
+	}
+}
+
+// backend is one of the execution clients the EngineClient can route
+// Engine API calls to.
+type backend[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+] struct {
+	// index is the position of the backend in the configured endpoints.
+	index int
+	// url is the url of the execution client JSON-RPC endpoint.
+	url *url.ConnectionURL
+	// jwtSecret is the JWT secret for the execution client.
+	jwtSecret *jwt.Secret
+	// client is the connection to the execution client, nil if it has not
+	// been dialed yet.
+	client *ethclient.Eth1Client[ExecutionPayloadT]
+	// status is the health of the execution client.
+	status backendStatus
+}
+
+// isHTTP returns true if the backend is dialed over HTTP(S).
+func (b *backend[_]) isHTTP() bool {
+	return b.url.IsHTTP() || b.url.IsHTTPS()
+}
+
+// close closes the connection to the execution client, if any.
+func (b *backend[_]) close() {
+	if b.client != nil && b.client.Client != nil {
+		b.client.Close()
+	}
+	b.client = nil
+}
@@ -24,7 +24,7 @@ import (
 	"context"
 	"math/big"
 	"net/http"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -42,14 +42,19 @@ type EngineClient[
 	PayloadAttributesT PayloadAttributes,
 ] struct {
 	// Eth1Client is a struct that holds the Ethereum 1 client and
-	// its configuration.
+	// its configuration. It always points to the client of the active
+	// backend.
 	*ethclient.Eth1Client[ExecutionPayloadT]
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
 	// logger is the logger for the engine client.
 	logger log.Logger[any]
-	// jwtSecret is the JWT secret for the execution client.
-	jwtSecret *jwt.Secret
+	// mu guards the backends, the active backend and its capabilities.
+	mu sync.RWMutex
+	// backends are the execution clients in order of preference.
+	backends []*backend[ExecutionPayloadT]
+	// active is the index of the backend Engine API calls are routed to.
+	active int
 	// eth1ChainID is the chain ID of the execution client.
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
@@ -62,24 +67,36 @@ type EngineClient[
 }
 
 // New creates a new engine client EngineClient.
-// It takes an Eth1Client as an argument and returns a pointer  to an
-// EngineClient.
+// It takes the JWT secrets of the configured endpoints, in the order of
+// Config.Endpoints, and returns a pointer to an EngineClient.
 func New[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 	PayloadAttributesT PayloadAttributes,
 ](
 	cfg *Config,
 	logger log.Logger[any],
-	jwtSecret *jwt.Secret,
+	jwtSecrets []*jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
+	endpoints := cfg.Endpoints()
+	backends := make([]*backend[ExecutionPayloadT], len(endpoints))
+	for i, endpoint := range endpoints {
+		backends[i] = &backend[ExecutionPayloadT]{
+			index: i,
+			url:   endpoint.RPCDialURL,
+		}
+		if i < len(jwtSecrets) {
+			backends[i].jwtSecret = jwtSecrets[i]
+		}
+	}
+
 	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
 		cfg:          cfg,
 		logger:       logger,
-		jwtSecret:    jwtSecret,
+		backends:     backends,
 		Eth1Client:   new(ethclient.Eth1Client[ExecutionPayloadT]),
 		capabilities: make(map[string]struct{}),
 		engineCache:  cache.NewEngineCacheWithDefaultConfig(),
@@ -101,28 +118,36 @@ func (s *EngineClient[
 ]) Start(
 	ctx context.Context,
 ) error {
-	if s.cfg.RPCDialURL.IsHTTP() || s.cfg.RPCDialURL.IsHTTPS() {
+	var refreshJWT bool
+	for _, b := range s.backends {
+		if !b.isHTTP() {
+			continue
+		}
+		if b.jwtSecret == nil {
+			s.logger.Warn(
+				"JWT secret not provided for http(s) connection"+
+					" - please verify your configuration settings",
+				"dial_url", b.url.String(),
+			)
+			continue
+		}
+		refreshJWT = true
+	}
+	if refreshJWT {
 		// If we are dialing with HTTP(S), start the JWT refresh loop.
-		defer func() {
-			if s.jwtSecret == nil {
-				s.logger.Warn(
-					"JWT secret not provided for http(s) connection" +
-						" - please verify your configuration settings",
-				)
-				return
-			}
-			go s.jwtRefreshLoop(ctx)
-		}()
+		defer func() { go s.jwtRefreshLoop(ctx) }()
 	}
 
 	s.logger.Info(
 		"Initializing connection to the execution client...",
 		"dial_url", s.cfg.RPCDialURL.String(),
+		"fallbacks", len(s.backends)-1,
 	)
 
 	// If the connection connection succeeds, we can skip the
 	// connection initialization loop.
 	if err := s.initializeConnection(ctx); err == nil {
+		go s.healthCheckLoop(ctx)
 		return nil
 	}
 
@@ -139,11 +164,9 @@ func (s *EngineClient[
 				"dial_url", s.cfg.RPCDialURL,
 			)
 			if err := s.initializeConnection(ctx); err != nil {
-				if errors.Is(err, ErrMismatchedEth1ChainID) {
-					s.logger.Error(err.Error())
-				}
 				continue
 			}
+			go s.healthCheckLoop(ctx)
 			return nil
 		}
 	}
@@ -153,78 +176,31 @@ func (s *EngineClient[
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
 
-// setupConnection dials the execution client and
-// ensures the chain ID is correct.
+// initializeConnection health checks the execution clients and routes
+// Engine API calls to the healthiest one.
 func (s *EngineClient[
 	_, _,
 ]) initializeConnection(
 	ctx context.Context,
 ) error {
-	var (
-		err     error
-		chainID *big.Int
-	)
-
-	defer func() {
-		if err != nil {
-			s.Client.Close()
-		}
-	}()
-
-	// Dial the execution client.
-	if err = s.dialExecutionRPCClient(ctx); err != nil {
-		return err
-	}
-
-	// After the initial dial, check to make sure the chain ID is correct.
-	chainID, err = s.Client.ChainID(ctx)
-	if err != nil {
-		if strings.Contains(err.Error(), "401 Unauthorized") {
-			// We always log this error as it is a critical error.
-			s.logger.Error(UnauthenticatedConnectionErrorStr)
-		}
-		return err
-	}
-
-	if chainID.Uint64() != s.eth1ChainID.Uint64() {
-		err = errors.Wrapf(
-			ErrMismatchedEth1ChainID,
-			"wanted chain ID %d, got %d",
-			s.eth1ChainID,
-			chainID.Uint64(),
-		)
+	if err := s.checkBackends(ctx); err != nil {
 		return err
 	}
-
-	// Log the chain ID.
-	s.logger.Info(
-		"Connected to execution client 🔌",
-		"dial_url",
-		s.cfg.RPCDialURL.String(),
-		"chain_id",
-		chainID.Uint64(),
-		"required_chain_id",
-		s.eth1ChainID,
-	)
-
-	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
-		s.logger.Error("failed to exchange capabilities", "err", err)
-		return err
-	}
-	return nil
+	return s.selectBackend(ctx)
 }
 
 /* -------------------------------------------------------------------------- */
 /*                                   Dialing                                  */
 /* -------------------------------------------------------------------------- */
 
-// dialExecutionRPCClient dials the execution client's RPC endpoint.
+// dialExecutionRPCClient dials the RPC endpoint of the given execution
+// client.
 func (s *EngineClient[
 	ExecutionPayloadT, _,
 ]) dialExecutionRPCClient(
 	ctx context.Context,
-) error {
+	b *backend[ExecutionPayloadT],
+) (*ethclient.Eth1Client[ExecutionPayloadT], error) {
 	var (
 		client *rpc.Client
 		err    error
@@ -232,40 +208,36 @@ func (s *EngineClient[
 
 	// Dial the execution client based on the URL scheme.
 	switch {
-	case s.cfg.RPCDialURL.IsHTTP(), s.cfg.RPCDialURL.IsHTTPS():
+	case b.isHTTP():
 		// Build an http.Header with the JWT token attached.
-		if s.jwtSecret != nil {
+		if b.jwtSecret != nil {
 			var header http.Header
-			if header, err = s.buildJWTHeader(); err != nil {
-				return err
+			if header, err = s.buildJWTHeader(b.jwtSecret); err != nil {
+				return nil, err
 			}
 			if client, err = rpc.DialOptions(
-				ctx, s.cfg.RPCDialURL.String(), rpc.WithHeaders(header),
+				ctx, b.url.String(), rpc.WithHeaders(header),
 			); err != nil {
-				return err
+				return nil, err
 			}
 		} else {
 			if client, err = rpc.DialContext(
-				ctx, s.cfg.RPCDialURL.String()); err != nil {
-				return err
+				ctx, b.url.String()); err != nil {
+				return nil, err
 			}
 		}
-	case s.cfg.RPCDialURL.IsIPC():
+	case b.url.IsIPC():
 		if client, err = rpc.DialIPC(
-			ctx, s.cfg.RPCDialURL.Path); err != nil {
+			ctx, b.url.Path); err != nil {
 			s.logger.Error("failed to dial IPC", "err", err)
-			return err
+			return nil, err
 		}
 	default:
-		return errors.Newf(
+		return nil, errors.Newf(
 			"no known transport for URL scheme %q",
-			s.cfg.RPCDialURL.Scheme,
+			b.url.Scheme,
 		)
 	}
 
-	// Refresh the execution client with the new client.
-	s.Eth1Client, err = ethclient.NewFromRPCClient[ExecutionPayloadT](
-		client,
-	)
-	return err
+	return ethclient.NewFromRPCClient[ExecutionPayloadT](client)
 }
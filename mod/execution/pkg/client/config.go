@@ -31,6 +31,7 @@ const (
 	defaultRPCRetries              = 3
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
+	defaultRPCHealthCheckInterval  = 5 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
@@ -45,6 +46,7 @@ func DefaultConfig() Config {
 		RPCRetries:              defaultRPCRetries,
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
+		RPCHealthCheckInterval:  defaultRPCHealthCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
 	}
@@ -63,8 +65,38 @@ type Config struct {
 	RPCTimeout time.Duration `mapstructure:"rpc-timeout"`
 	// RPCStartupCheckInterval is the Interval for the startup check.
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
+	// RPCHealthCheckInterval is the interval at which the execution clients
+	// are health checked.
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// RPCFallbacks are the standby execution clients to fail over to, in
+	// order of preference, when the one at RPCDialURL is down or syncing.
+	RPCFallbacks []Endpoint `mapstructure:"rpc-fallbacks"`
+	// RPCVerifiers are additional execution clients that new payloads are
+	// verified against when VerificationQuorum is set.
+	RPCVerifiers []Endpoint `mapstructure:"rpc-verifiers"`
+	// VerificationQuorum is the number of execution clients, out of the
+	// active one and the RPCVerifiers, that must deem a new payload VALID for
+	// it to be accepted. Zero disables the verification.
+	VerificationQuorum uint64 `mapstructure:"verification-quorum"`
+}
+
+// Endpoint is the configuration of a standby execution client.
+type Endpoint struct {
+	// RPCDialURL is the url of the execution client JSON-RPC endpoint.
+	RPCDialURL *url.ConnectionURL `mapstructure:"rpc-dial-url"`
+	// JWTSecretPath is the path to the JWT secret of the execution client.
+	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+}
+
+// Endpoints returns the execution client endpoints in order of preference,
+// starting with the one at RPCDialURL.
+func (c Config) Endpoints() []Endpoint {
+	return append(
+		[]Endpoint{{RPCDialURL: c.RPCDialURL, JWTSecretPath: c.JWTSecretPath}},
+		c.RPCFallbacks...,
+	)
 }
@@ -28,7 +28,9 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -44,6 +46,8 @@ func (s *EngineClient[
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+	forkVersion uint32,
 ) (*common.ExecutionHash, error) {
 	var (
 		startTime    = time.Now()
@@ -52,10 +56,29 @@ func (s *EngineClient[
 	defer s.metrics.measureNewPayloadDuration(startTime)
 	defer cancel()
 
+	// Keep the standby execution clients in sync.
+	s.feedStandbys(ctx, "newPayload", func(
+		ctx context.Context, c *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		_, err := c.NewPayload(
+			ctx, payload, versionedHashes, parentBeaconBlockRoot,
+			executionRequests, forkVersion,
+		)
+		return err
+	})
+
 	// Call the appropriate RPC method based on the payload version.
-	result, err := s.Eth1Client.NewPayload(
-		cctx, payload, versionedHashes, parentBeaconBlockRoot,
-	)
+	var result *engineprimitives.PayloadStatusV1
+	err := s.callWithFailover(cctx, func(
+		ctx context.Context, c *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = c.NewPayload(
+			ctx, payload, versionedHashes, parentBeaconBlockRoot,
+			executionRequests, forkVersion,
+		)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementNewPayloadTimeout()
@@ -84,7 +107,7 @@ func (s *EngineClient[
 
 // ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
 func (s *EngineClient[
-	_, PayloadAttributesT,
+	ExecutionPayloadT, PayloadAttributesT,
 ]) ForkchoiceUpdated(
 	ctx context.Context,
 	state *engineprimitives.ForkchoiceStateV1,
@@ -107,10 +130,23 @@ func (s *EngineClient[
 		)
 	}
 
-	result, err := s.Eth1Client.ForkchoiceUpdated(
-		cctx, state, attrs, forkVersion,
-	)
+	// Keep the standby execution clients in sync, without having them build
+	// a payload.
+	s.feedStandbys(ctx, "forkchoiceUpdated", func(
+		ctx context.Context, c *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		_, err := c.ForkchoiceUpdated(ctx, state, nil, forkVersion)
+		return err
+	})
 
+	var result *engineprimitives.ForkchoiceResponseV1
+	err := s.callWithFailover(cctx, func(
+		ctx context.Context, c *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = c.ForkchoiceUpdated(ctx, state, attrs, forkVersion)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementForkchoiceUpdateTimeout()
@@ -147,8 +183,13 @@ func (s *EngineClient[
 	defer s.metrics.measureGetPayloadDuration(startTime)
 	defer cancel()
 
-	// Call and check for errors.
-	result, err := s.Eth1Client.GetPayload(cctx, payloadID, forkVersion)
+	// Call and check for errors. The payload is only known to the execution
+	// client it was requested from, so there is no failing over here.
+	_, client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.GetPayload(cctx, payloadID, forkVersion)
 	switch {
 	case err != nil:
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -165,6 +206,52 @@ func (s *EngineClient[
 	return result, nil
 }
 
+/* -------------------------------------------------------------------------- */
+/*                                  GetBlobs                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetBlobs calls the engine_getBlobsV1 method via JSON-RPC. It returns the
+// blobs and proofs in the mempool of the execution client for the given
+// versioned hashes, in the same order and with a nil entry for every blob
+// that is missing.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) GetBlobs(
+	ctx context.Context,
+	versionedHashes []common.ExecutionHash,
+) ([]*engineprimitives.BlobAndProofV1[eip4844.KZGProof, eip4844.Blob], error) {
+	s.mu.RLock()
+	_, ok := s.capabilities[ethclient.GetBlobsMethodV1]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, ErrGetBlobsNotSupported
+	}
+
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	var result []*engineprimitives.BlobAndProofV1[
+		eip4844.KZGProof, eip4844.Blob,
+	]
+	err := s.callWithFailover(cctx, func(
+		ctx context.Context, c *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = c.GetBlobsV1(ctx, versionedHashes)
+		return err
+	})
+	switch {
+	case err != nil:
+		return nil, s.handleRPCError(err)
+	case len(result) != len(versionedHashes):
+		return nil, errors.Wrapf(
+			ErrMismatchedNumBlobs, "expected %d, got %d",
+			len(versionedHashes), len(result),
+		)
+	}
+	return result, nil
+}
+
 // ExchangeCapabilities calls the engine_exchangeCapabilities method via
 // JSON-RPC.
 func (s *EngineClient[
@@ -172,7 +259,11 @@ func (s *EngineClient[
 ]) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Eth1Client.ExchangeCapabilities(
+	_, client, err := s.activeClient()
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
@@ -180,14 +271,21 @@ func (s *EngineClient[
 	}
 
 	// Capture and log the capabilities that the execution client has.
+	capabilities := make(map[string]struct{}, len(result))
 	for _, capability := range result {
 		s.logger.Info("Exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+		capabilities[capability] = struct{}{}
 	}
 
+	// Swap in the capabilities of the execution client at once, as they are
+	// read concurrently.
+	s.mu.Lock()
+	s.capabilities = capabilities
+	s.mu.Unlock()
+
 	// Log the capabilities that the execution client does not have.
 	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if _, exists := s.capabilities[capability]; !exists {
+		if _, exists := capabilities[capability]; !exists {
 			s.logger.Warn(
 				"Your execution client may require an update 🚸",
 				"unsupported_capability", capability,
@@ -48,12 +48,32 @@ var (
 	// ErrNotStarted indicates that the execution client is not started.
 	ErrNotStarted = errors.New("engine client is not started")
 
+	// ErrNotConnected indicates that no connection to the execution client
+	// has been established yet.
+	ErrNotConnected = errors.New("execution client is not connected")
+
 	// ErrFailedToRefreshJWT indicates that the JWT could not be refreshed.
 	ErrFailedToRefreshJWT = errors.New("failed to refresh auth token")
 
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
+
+	// ErrNoExecutionClientAvailable is returned when none of the configured
+	// execution clients is reachable.
+	ErrNoExecutionClientAvailable = errors.New(
+		"no execution client available",
+	)
+
+	// ErrGetBlobsNotSupported is returned when the execution client does not
+	// support retrieving blobs from its mempool.
+	ErrGetBlobsNotSupported = errors.New(
+		"execution client does not support engine_getBlobsV1",
+	)
+
+	// ErrMismatchedNumBlobs is returned when the execution client does not
+	// return an entry for every requested versioned hash.
+	ErrMismatchedNumBlobs = errors.New("mismatched number of blobs")
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -24,33 +24,37 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
-// HeaderByNumber retrieves the block header by its number.
+// HeaderByNumber retrieves the block header by its number from the active
+// execution client.
 func (s *EngineClient[
-	_, _,
+	ExecutionPayloadT, _,
 ]) HeaderByNumber(
 	ctx context.Context,
 	number *big.Int,
 ) (*gethprimitives.Header, error) {
-	// Infer the latest height if the number is nil.
-	if number == nil {
-		latest, err := s.BlockNumber(ctx)
-		if err != nil {
-			return nil, err
-		}
-		number = new(big.Int).SetUint64(latest)
-	}
-
 	// Check the cache for the header.
-	if header, ok := s.engineCache.HeaderByNumber(number.Uint64()); ok {
-		return header, nil
+	if number != nil {
+		if header, ok := s.engineCache.HeaderByNumber(
+			number.Uint64(),
+		); ok {
+			return header, nil
+		}
 	}
 
-	header, err := s.Client.HeaderByNumber(ctx, number)
-	if err != nil {
+	var header *gethprimitives.Header
+	if err := s.callWithFailover(ctx, func(
+		ctx context.Context, c *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		// A nil number requests the latest header.
+		var err error
+		header, err = c.HeaderByNumber(ctx, number)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 
@@ -60,9 +64,10 @@ func (s *EngineClient[
 	return header, nil
 }
 
-// HeaderByHash retrieves the block header by its hash.
+// HeaderByHash retrieves the block header by its hash from the active
+// execution client.
 func (s *EngineClient[
-	_, _,
+	ExecutionPayloadT, _,
 ]) HeaderByHash(
 	ctx context.Context,
 	hash common.ExecutionHash,
@@ -72,11 +77,13 @@ func (s *EngineClient[
 	if ok {
 		return header, nil
 	}
-	header, err := s.Client.HeaderByHash(
-		ctx,
-		gethprimitives.ExecutionHash(hash),
-	)
-	if err != nil {
+	if err := s.callWithFailover(ctx, func(
+		ctx context.Context, c *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		var err error
+		header, err = c.HeaderByHash(ctx, gethprimitives.ExecutionHash(hash))
+		return err
+	}); err != nil {
 		return nil, err
 	}
 	s.engineCache.AddHeader(header)
@@ -27,6 +27,9 @@ func BeaconKitSupportedCapabilities() []string {
 		NewPayloadMethodV3,
 		ForkchoiceUpdatedMethodV3,
 		GetPayloadMethodV3,
+		NewPayloadMethodV4,
+		GetPayloadMethodV4,
+		GetBlobsMethodV1,
 		GetClientVersionV1,
 	}
 }
@@ -39,6 +42,13 @@ const (
 	ForkchoiceUpdatedMethodV3 = "engine_forkchoiceUpdatedV3"
 	// GetPayloadMethodV3 for retrieving a payload in Deneb.
 	GetPayloadMethodV3 = "engine_getPayloadV3"
+	// NewPayloadMethodV4 for creating a new payload in Electra.
+	NewPayloadMethodV4 = "engine_newPayloadV4"
+	// GetPayloadMethodV4 for retrieving a payload in Electra.
+	GetPayloadMethodV4 = "engine_getPayloadV4"
+	// GetBlobsMethodV1 for retrieving blobs from the mempool of the execution
+	// client.
+	GetBlobsMethodV1 = "engine_getBlobsV1"
 	// BlockByHashMethod for retrieving a block by its hash.
 	BlockByHashMethod = "eth_getBlockByHash"
 	// BlockByNumberMethod for retrieving a block by its number.
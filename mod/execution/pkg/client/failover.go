@@ -0,0 +1,341 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/http"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                Health Checks                               */
+/* -------------------------------------------------------------------------- */
+
+// healthCheckLoop periodically health checks the execution clients and
+// routes Engine API calls to the healthiest one.
+func (s *EngineClient[
+	_, _,
+]) healthCheckLoop(
+	ctx context.Context,
+) {
+	ticker := time.NewTicker(s.cfg.RPCHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.initializeConnection(ctx); err != nil {
+				s.logger.Error(
+					"No execution client is available 🚨",
+					"err", err,
+				)
+			}
+		}
+	}
+}
+
+// checkBackends health checks all the execution clients. It returns an error
+// if none of them is available.
+func (s *EngineClient[
+	_, _,
+]) checkBackends(
+	ctx context.Context,
+) error {
+	var errs []error
+	for _, b := range s.backends {
+		if err := s.checkBackend(ctx, b); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == len(s.backends) {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// checkBackend health checks the given execution client, dialing it first
+// if needed, and records its status.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) checkBackend(
+	ctx context.Context,
+	b *backend[ExecutionPayloadT],
+) error {
+	s.mu.RLock()
+	client, prevStatus := b.client, b.status
+	s.mu.RUnlock()
+
+	var err error
+	if client == nil {
+		if client, err = s.dialExecutionRPCClient(ctx, b); err == nil {
+			s.mu.Lock()
+			b.client = client
+			s.mu.Unlock()
+		}
+	}
+
+	status := backendUnavailable
+	if err == nil {
+		status, err = s.probeBackend(ctx, client)
+	}
+
+	s.mu.Lock()
+	b.status = status
+	s.mu.Unlock()
+	s.metrics.setBackendStatus(b.index, status)
+
+	switch {
+	case err != nil && prevStatus != backendUnavailable:
+		s.logger.Warn(
+			"Lost connection to execution client 🔌",
+			"dial_url", b.url.String(),
+			"err", err,
+		)
+	case err == nil && prevStatus == backendUnavailable:
+		s.logger.Info(
+			"Connected to execution client 🔌",
+			"dial_url", b.url.String(),
+			"chain_id", s.eth1ChainID,
+			"status", status,
+		)
+	}
+	return err
+}
+
+// probeBackend ensures the chain ID of the given execution client is
+// correct and reports whether it is synced.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) probeBackend(
+	ctx context.Context,
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+) (backendStatus, error) {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		if strings.Contains(err.Error(), "401 Unauthorized") {
+			// We always log this error as it is a critical error.
+			s.logger.Error(UnauthenticatedConnectionErrorStr)
+		}
+		return backendUnavailable, err
+	}
+
+	if chainID.Uint64() != s.eth1ChainID.Uint64() {
+		err = errors.Wrapf(
+			ErrMismatchedEth1ChainID,
+			"wanted chain ID %d, got %d",
+			s.eth1ChainID,
+			chainID.Uint64(),
+		)
+		s.logger.Error(err.Error())
+		return backendUnavailable, err
+	}
+
+	progress, err := client.SyncProgress(ctx)
+	switch {
+	case err != nil:
+		return backendUnavailable, err
+	case progress != nil:
+		return backendSyncing, nil
+	default:
+		return backendSynced, nil
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Routing                                  */
+/* -------------------------------------------------------------------------- */
+
+// selectBackend routes Engine API calls to the healthiest execution client,
+// i.e. the first synced one in order of preference or, failing that, the
+// first syncing one.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) selectBackend(
+	ctx context.Context,
+) error {
+	s.mu.Lock()
+	var next *backend[ExecutionPayloadT]
+	for _, b := range s.backends {
+		if b.status == backendSynced {
+			next = b
+			break
+		}
+		if b.status == backendSyncing && next == nil {
+			next = b
+		}
+	}
+	if next == nil {
+		s.mu.Unlock()
+		return ErrNoExecutionClientAvailable
+	}
+
+	prev := s.backends[s.active]
+	switched := s.Eth1Client != next.client
+	s.active = next.index
+	s.Eth1Client = next.client
+	if switched {
+		// The capabilities of the previous execution client no longer
+		// apply until they are exchanged with the new one.
+		s.capabilities = make(map[string]struct{})
+	}
+	s.mu.Unlock()
+
+	s.metrics.setActiveBackend(next.index)
+	if !switched {
+		return nil
+	}
+
+	if prev != next {
+		s.metrics.incrementFailover()
+		s.logger.Warn(
+			"Switching execution client 🔀",
+			"from", prev.url.String(),
+			"to", next.url.String(),
+			"status", next.status,
+		)
+	}
+
+	// Exchange capabilities with the newly active execution client.
+	if _, err := s.ExchangeCapabilities(ctx); err != nil {
+		s.logger.Error("failed to exchange capabilities", "err", err)
+		return err
+	}
+	return nil
+}
+
+// activeClient returns the backend Engine API calls are routed to, along with
+// its client. It returns ErrNotConnected if no connection to the execution
+// client has been established yet.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) activeClient() (
+	*backend[ExecutionPayloadT], *ethclient.Eth1Client[ExecutionPayloadT],
+	error,
+) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.Eth1Client == nil || s.Eth1Client.Client == nil {
+		return s.backends[s.active], nil, ErrNotConnected
+	}
+	return s.backends[s.active], s.Eth1Client, nil
+}
+
+// callWithFailover calls fn against the active execution client. If the
+// active execution client cannot be reached, Engine API calls are routed to
+// the healthiest remaining one and fn is retried there once.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) callWithFailover(
+	ctx context.Context,
+	fn func(context.Context, *ethclient.Eth1Client[ExecutionPayloadT]) error,
+) error {
+	active, client, err := s.activeClient()
+	if err == nil {
+		err = fn(ctx, client)
+	}
+	if err == nil || !isConnectionError(err) || len(s.backends) == 1 {
+		return err
+	}
+
+	s.mu.Lock()
+	active.status = backendUnavailable
+	s.mu.Unlock()
+	s.metrics.setBackendStatus(active.index, backendUnavailable)
+	s.logger.Warn(
+		"Lost connection to execution client 🔌",
+		"dial_url", active.url.String(),
+		"err", err,
+	)
+
+	if selectErr := s.selectBackend(ctx); selectErr != nil {
+		return err
+	}
+	next, client, nextErr := s.activeClient()
+	if nextErr != nil || next == active {
+		return err
+	}
+	return fn(ctx, client)
+}
+
+// feedStandbys asynchronously calls fn against every reachable execution
+// client other than the active one, so that the standbys keep following the
+// chain and are ready to take over.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) feedStandbys(
+	ctx context.Context,
+	method string,
+	fn func(context.Context, *ethclient.Eth1Client[ExecutionPayloadT]) error,
+) {
+	s.mu.RLock()
+	standbys := make([]*backend[ExecutionPayloadT], 0, len(s.backends)-1)
+	clients := make(
+		[]*ethclient.Eth1Client[ExecutionPayloadT], 0, len(s.backends)-1,
+	)
+	for _, b := range s.backends {
+		if b.index == s.active || b.client == nil ||
+			b.status == backendUnavailable {
+			continue
+		}
+		standbys = append(standbys, b)
+		clients = append(clients, b.client)
+	}
+	s.mu.RUnlock()
+
+	for i, b := range standbys {
+		go func() {
+			cctx, cancel := context.WithTimeout(
+				context.WithoutCancel(ctx), s.cfg.RPCTimeout,
+			)
+			defer cancel()
+			if err := fn(cctx, clients[i]); err != nil {
+				s.logger.Warn(
+					"Failed to feed standby execution client",
+					"method", method,
+					"dial_url", b.url.String(),
+					"err", err,
+				)
+			}
+		}()
+	}
+}
+
+// isConnectionError returns true if the error indicates that the execution
+// client could not be reached, as opposed to an error returned by a
+// reachable execution client.
+func isConnectionError(err error) bool {
+	var rpcErr jsonrpc.Error
+	return !errors.As(err, &rpcErr) &&
+		!errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded) &&
+		!errors.Is(err, engineerrors.ErrEngineAPITimeout) &&
+		!errors.Is(err, ethclient.ErrNilResponse) &&
+		!errors.Is(err, ethclient.ErrInvalidVersion) &&
+		!http.IsTimeoutError(err)
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/stretchr/testify/require"
+)
+
+// testPayload is a minimal execution payload for the engine client.
+type testPayload struct{}
+
+func (*testPayload) Empty(uint32) *testPayload    { return new(testPayload) }
+func (*testPayload) Version() uint32              { return 0 }
+func (p *testPayload) IsNil() bool                { return p == nil }
+func (*testPayload) MarshalJSON() ([]byte, error) { return []byte("{}"), nil }
+func (*testPayload) UnmarshalJSON([]byte) error   { return nil }
+
+// testAttributes are minimal payload attributes for the engine client.
+type testAttributes struct{}
+
+func (a *testAttributes) IsNil() bool { return a == nil }
+func (*testAttributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+// noopTelemetrySink is a telemetry sink that drops the metrics.
+type noopTelemetrySink struct{}
+
+func (noopTelemetrySink) IncrementCounter(string, ...string)        {}
+func (noopTelemetrySink) SetGauge(string, int64, ...string)         {}
+func (noopTelemetrySink) MeasureSince(string, time.Time, ...string) {}
+
+// fakeExecutionClient is an execution client JSON-RPC endpoint that answers
+// the calls made by the health checks and serves a fixed header.
+type fakeExecutionClient struct {
+	*httptest.Server
+	syncing atomic.Bool
+	mu      sync.Mutex
+	calls   map[string]int
+}
+
+func newFakeExecutionClient(t *testing.T) *fakeExecutionClient {
+	t.Helper()
+	f := &fakeExecutionClient{calls: make(map[string]int)}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeExecutionClient) serveHTTP(
+	w http.ResponseWriter, r *http.Request,
+) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.mu.Lock()
+	f.calls[req.Method]++
+	f.mu.Unlock()
+
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	switch req.Method {
+	case "eth_chainId":
+		resp["result"] = "0x1"
+	case "eth_syncing":
+		if f.syncing.Load() {
+			resp["result"] = map[string]string{
+				"startingBlock": "0x0",
+				"currentBlock":  "0x1",
+				"highestBlock":  "0x2",
+			}
+		} else {
+			resp["result"] = false
+		}
+	case "engine_exchangeCapabilities":
+		resp["result"] = []string{}
+	case "eth_getBlockByNumber":
+		resp["result"] = &gethprimitives.Header{
+			Number:     big.NewInt(5),
+			Difficulty: big.NewInt(0),
+		}
+	default:
+		resp["error"] = map[string]any{
+			"code": -32601, "message": "method not found",
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (f *fakeExecutionClient) callCount(method string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[method]
+}
+
+// newTestEngineClient creates an engine client routing to the given
+// execution clients, in order of preference.
+func newTestEngineClient(
+	t *testing.T, fakes ...*fakeExecutionClient,
+) *EngineClient[*testPayload, *testAttributes] {
+	t.Helper()
+	cfg := DefaultConfig()
+	for i, f := range fakes {
+		dialURL, err := url.NewFromRaw(f.URL)
+		require.NoError(t, err)
+		if i == 0 {
+			cfg.RPCDialURL = dialURL
+			continue
+		}
+		cfg.RPCFallbacks = append(
+			cfg.RPCFallbacks, Endpoint{RPCDialURL: dialURL},
+		)
+	}
+	return New[*testPayload, *testAttributes](
+		&cfg, noop.NewLogger[any](), nil, noopTelemetrySink{}, big.NewInt(1),
+	)
+}
+
+func TestSelectBackend(t *testing.T) {
+	ctx := context.Background()
+	fakes := []*fakeExecutionClient{
+		newFakeExecutionClient(t),
+		newFakeExecutionClient(t),
+		newFakeExecutionClient(t),
+	}
+	fakes[0].syncing.Store(true)
+	s := newTestEngineClient(t, fakes...)
+
+	// The first synced execution client is preferred over a syncing one.
+	require.NoError(t, s.initializeConnection(ctx))
+	require.Equal(t, 1, s.active)
+	require.Equal(t, backendSyncing, s.backends[0].status)
+	require.Equal(t, 1, fakes[1].callCount("engine_exchangeCapabilities"))
+
+	// Once synced, the execution client of highest preference takes over.
+	fakes[0].syncing.Store(false)
+	require.NoError(t, s.initializeConnection(ctx))
+	require.Equal(t, 0, s.active)
+	require.Equal(t, 1, fakes[0].callCount("engine_exchangeCapabilities"))
+
+	// Staying on the same execution client does not exchange capabilities.
+	require.NoError(t, s.initializeConnection(ctx))
+	require.Equal(t, 1, fakes[0].callCount("engine_exchangeCapabilities"))
+
+	// A syncing execution client is used if none is synced.
+	fakes[0].Close()
+	fakes[1].Close()
+	fakes[2].syncing.Store(true)
+	require.NoError(t, s.initializeConnection(ctx))
+	require.Equal(t, 2, s.active)
+	require.Equal(t, backendUnavailable, s.backends[0].status)
+	require.Equal(t, backendUnavailable, s.backends[1].status)
+
+	// No execution client is available.
+	fakes[2].Close()
+	require.Error(t, s.initializeConnection(ctx))
+	require.ErrorIs(t, s.selectBackend(ctx), ErrNoExecutionClientAvailable)
+}
+
+func TestCallWithFailover(t *testing.T) {
+	ctx := context.Background()
+	primary, standby := newFakeExecutionClient(t), newFakeExecutionClient(t)
+	s := newTestEngineClient(t, primary, standby)
+	require.NoError(t, s.initializeConnection(ctx))
+	require.Equal(t, 0, s.active)
+
+	header, err := s.HeaderByNumber(ctx, big.NewInt(5))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), header.Number.Uint64())
+	require.Equal(t, 1, primary.callCount("eth_getBlockByNumber"))
+
+	// Errors returned by a reachable execution client do not fail over.
+	err = s.callWithFailover(ctx, func(
+		ctx context.Context, c *ethclient.Eth1Client[*testPayload],
+	) error {
+		return c.Client.Client().CallContext(ctx, nil, "eth_unknown")
+	})
+	require.Error(t, err)
+	require.False(t, isConnectionError(err))
+	require.Equal(t, 0, s.active)
+
+	// The call is retried on the standby once the active execution client
+	// cannot be reached.
+	primary.Close()
+	header, err = s.HeaderByNumber(ctx, big.NewInt(6))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), header.Number.Uint64())
+	require.Equal(t, 1, s.active)
+	require.Equal(t, backendUnavailable, s.backends[0].status)
+	require.Equal(t, 1, standby.callCount("eth_getBlockByNumber"))
+	require.Equal(t, 1, standby.callCount("engine_exchangeCapabilities"))
+
+	// The error is returned once no execution client can be reached.
+	standby.Close()
+	_, err = s.HeaderByNumber(ctx, big.NewInt(7))
+	require.Error(t, err)
+	require.True(t, isConnectionError(err))
+}
+
+func TestFeedStandbys(t *testing.T) {
+	ctx := context.Background()
+	fakes := []*fakeExecutionClient{
+		newFakeExecutionClient(t),
+		newFakeExecutionClient(t),
+		newFakeExecutionClient(t),
+	}
+	s := newTestEngineClient(t, fakes...)
+	require.NoError(t, s.initializeConnection(ctx))
+
+	// Unreachable standbys are not fed.
+	fakes[2].Close()
+	require.Error(t, s.checkBackend(ctx, s.backends[2]))
+	require.Equal(t, backendUnavailable, s.backends[2].status)
+
+	var (
+		mu  sync.Mutex
+		fed []*ethclient.Eth1Client[*testPayload]
+		wg  sync.WaitGroup
+	)
+	wg.Add(1)
+	s.feedStandbys(ctx, "test", func(
+		_ context.Context, c *ethclient.Eth1Client[*testPayload],
+	) error {
+		defer wg.Done()
+		mu.Lock()
+		defer mu.Unlock()
+		fed = append(fed, c)
+		return nil
+	})
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(
+		t, []*ethclient.Eth1Client[*testPayload]{s.backends[1].client}, fed,
+	)
+}
@@ -21,6 +21,7 @@
 package client
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/log"
@@ -99,6 +100,31 @@ func (cm *clientMetrics) incrementHTTPTimeoutCounter() {
 	cm.incrementTimeoutCounter("beacon_kit.execution.client.http")
 }
 
+// setActiveBackend sets the gauge for the index of the execution client
+// Engine API calls are routed to.
+func (cm *clientMetrics) setActiveBackend(index int) {
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.active_backend",
+		int64(index),
+	)
+}
+
+// setBackendStatus sets the gauge for the status of the execution client
+// at the given index.
+func (cm *clientMetrics) setBackendStatus(index int, status backendStatus) {
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.backend_status",
+		int64(status),
+		"backend", strconv.Itoa(index),
+	)
+}
+
+// incrementFailover increments the counter for switches of the execution
+// client Engine API calls are routed to.
+func (cm *clientMetrics) incrementFailover() {
+	cm.sink.IncrementCounter("beacon_kit.execution.client.failover")
+}
+
 // incrementTimeoutCounter increments the timeout counter for
 // the given metric.
 func (cm *clientMetrics) incrementTimeoutCounter(metricName string) {
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit/merkle"
+)
+
+const (
+	// depositSnapshotPath is the beacon API path of the deposit snapshot.
+	depositSnapshotPath = "/eth/v1/beacon/deposit_snapshot"
+	// snapshotRequestTimeout is the timeout for fetching the deposit
+	// snapshot from a trusted node.
+	snapshotRequestTimeout = 30 * time.Second
+)
+
+// ErrSnapshotRequestFailed is returned when the trusted node does not serve
+// the deposit snapshot.
+var ErrSnapshotRequestFailed = errors.New("deposit snapshot request failed")
+
+// snapshotResponse is the beacon API response of the deposit snapshot
+// endpoint.
+type snapshotResponse struct {
+	Data *merkle.DepositTreeSnapshot `json:"data"`
+}
+
+// bootstrapSnapshot returns the deposit snapshot configured to bootstrap the
+// deposit tree from, or nil if none is configured. The snapshot file takes
+// precedence over the trusted node URL.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) bootstrapSnapshot(
+	ctx context.Context,
+) (*merkle.DepositTreeSnapshot, error) {
+	switch {
+	case s.cfg.SnapshotPath != "":
+		return readSnapshotFile(s.cfg.SnapshotPath)
+	case s.cfg.SnapshotURL != "":
+		return fetchSnapshot(ctx, s.cfg.SnapshotURL)
+	default:
+		return nil, nil
+	}
+}
+
+// readSnapshotFile reads a deposit snapshot from the given file, which holds
+// either the snapshot itself or the full beacon API response.
+func readSnapshotFile(path string) (*merkle.DepositTreeSnapshot, error) {
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	return decodeSnapshot(bz)
+}
+
+// fetchSnapshot fetches the deposit snapshot from the beacon API of the
+// trusted node at the given URL.
+func fetchSnapshot(
+	ctx context.Context,
+	baseURL string,
+) (*merkle.DepositTreeSnapshot, error) {
+	endpoint, err := url.JoinPath(baseURL, depositSnapshotPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, snapshotRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, endpoint, http.NoBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(
+			ErrSnapshotRequestFailed, "status %d from %s",
+			resp.StatusCode, endpoint,
+		)
+	}
+
+	response := new(snapshotResponse)
+	if err = json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, err
+	}
+	if response.Data == nil {
+		return nil, errors.Wrapf(
+			ErrSnapshotRequestFailed, "no snapshot from %s", endpoint,
+		)
+	}
+	return response.Data, nil
+}
+
+// decodeSnapshot decodes a deposit snapshot, optionally wrapped in the
+// beacon API response.
+func decodeSnapshot(bz []byte) (*merkle.DepositTreeSnapshot, error) {
+	response := new(snapshotResponse)
+	if err := json.Unmarshal(bz, response); err != nil {
+		return nil, err
+	}
+	if response.Data != nil {
+		return response.Data, nil
+	}
+	snapshot := new(merkle.DepositTreeSnapshot)
+	if err := json.Unmarshal(bz, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import "time"
+
+const (
+	// defaultBatchSize is the default number of execution blocks whose
+	// deposit logs are scanned at once.
+	defaultBatchSize = 1000
+	// defaultRetryInterval is the default interval after which a failed
+	// scan is retried.
+	defaultRetryInterval = time.Second
+	// defaultMaxRetryInterval is the default maximum interval after which
+	// a failed scan is retried.
+	defaultMaxRetryInterval = time.Minute
+)
+
+// Config is the configuration for the deposit service.
+type Config struct {
+	// SnapshotPath is the path to an EIP-4881 deposit snapshot file, in the
+	// JSON format of the beacon API, to bootstrap the deposit tree from.
+	SnapshotPath string `mapstructure:"snapshot-path"`
+	// SnapshotURL is the beacon API URL of a trusted node to fetch the
+	// deposit snapshot to bootstrap the deposit tree from.
+	SnapshotURL string `mapstructure:"snapshot-url"`
+	// BatchSize is the maximum number of execution blocks whose deposit logs
+	// are scanned at once. It is halved whenever a scan fails.
+	BatchSize uint64 `mapstructure:"batch-size"`
+	// RetryInterval is the interval after which a failed scan is retried. It
+	// is doubled for every consecutive failure.
+	RetryInterval time.Duration `mapstructure:"retry-interval"`
+	// MaxRetryInterval is the maximum interval after which a failed scan is
+	// retried.
+	MaxRetryInterval time.Duration `mapstructure:"max-retry-interval"`
+}
+
+// DefaultConfig returns the default configuration for the deposit service.
+func DefaultConfig() Config {
+	return Config{
+		SnapshotPath:     "",
+		SnapshotURL:      "",
+		BatchSize:        defaultBatchSize,
+		RetryInterval:    defaultRetryInterval,
+		MaxRetryInterval: defaultMaxRetryInterval,
+	}
+}
@@ -23,6 +23,7 @@ package deposit
 import (
 	"context"
 	"errors"
+	"math/big"
 
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/bind"
@@ -39,6 +40,8 @@ type WrappedBeaconDepositContract[
 ] struct {
 	// BeaconDepositContract is a pointer to the codegen ABI binding.
 	deposit.BeaconDepositContract
+	// client is the execution client the contract is read from.
+	client bind.ContractBackend
 }
 
 // NewWrappedBeaconDepositContract creates a new BeaconDepositContract.
@@ -67,27 +70,31 @@ func NewWrappedBeaconDepositContract[
 		WithdrawalCredentialsT,
 	]{
 		BeaconDepositContract: *contract,
+		client:                client,
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads the deposits of the blocks in the inclusive range
+// [from, to] from the deposit contract, along with the hash of the last
+// block of the range.
 func (dc *WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
 ]) ReadDeposits(
 	ctx context.Context,
-	blkNum math.U64,
-) ([]DepositT, error) {
+	from, to math.U64,
+) ([]DepositT, common.ExecutionHash, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
-			Start:   blkNum.Unwrap(),
-			End:     (*uint64)(&blkNum),
+			Start:   from.Unwrap(),
+			End:     (*uint64)(&to),
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, common.ExecutionHash{}, err
 	}
+	defer logs.Close()
 
 	deposits := make([]DepositT, 0)
 	for logs.Next() {
@@ -101,6 +108,15 @@ func (dc *WrappedBeaconDepositContract[
 			logs.Event.Index,
 		))
 	}
+	if err = logs.Error(); err != nil {
+		return nil, common.ExecutionHash{}, err
+	}
 
-	return deposits, nil
+	header, err := dc.client.HeaderByNumber(
+		ctx, new(big.Int).SetUint64(to.Unwrap()),
+	)
+	if err != nil {
+		return nil, common.ExecutionHash{}, err
+	}
+	return deposits, common.ExecutionHash(header.Hash()), nil
 }
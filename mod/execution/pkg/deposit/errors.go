@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNoFollowedEth1Block is returned when no execution block at least
+	// the eth1 follow distance behind the execution block of a block being
+	// built is known to the deposit tree.
+	ErrNoFollowedEth1Block = errors.New(
+		"no eth1 block known at the follow distance",
+	)
+	// ErrEth1DataMismatch is returned when the eth1 data of a block does not
+	// match the deposit tree at the execution block it refers to.
+	ErrEth1DataMismatch = errors.New("eth1 data does not match deposit tree")
+	// ErrUnknownSnapshotFormat is returned when the deposit store is to be
+	// restored from a snapshot in an unsupported format.
+	ErrUnknownSnapshotFormat = errors.New("unknown deposit snapshot format")
+)
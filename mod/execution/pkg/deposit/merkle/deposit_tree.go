@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// DepositTree is an incremental Merkle tree of deposits that mirrors the
+// tree kept by the deposit contract, as specified in EIP-4881. Finalized
+// deposits are pruned from the tree, such that it only holds the leaves that
+// may still need to be proven. DepositTree is not safe for concurrent use.
+type DepositTree struct {
+	// tree is the root node of the deposit tree.
+	tree treeNode
+	// mixInLength is the number of deposits in the tree.
+	mixInLength uint64
+	// finalizedBlockHash is the hash of the execution block at which the
+	// tree was last finalized.
+	finalizedBlockHash common.ExecutionHash
+	// finalizedBlockHeight is the number of the execution block at which
+	// the tree was last finalized.
+	finalizedBlockHeight math.U64
+}
+
+// NewDepositTree creates an empty deposit tree.
+func NewDepositTree() *DepositTree {
+	return &DepositTree{
+		tree: &zeroNode{depth: constants.DepositContractTreeDepth},
+	}
+}
+
+// NewDepositTreeFromSnapshot rebuilds a deposit tree from a snapshot. The
+// returned tree can be extended with the deposits that follow the snapshot.
+func NewDepositTreeFromSnapshot(
+	snapshot *DepositTreeSnapshot,
+) (*DepositTree, error) {
+	if snapshot.CalculateRoot() != snapshot.DepositRoot {
+		return nil, errors.Wrap(
+			ErrInvalidSnapshot, "deposit root mismatch",
+		)
+	}
+	tree, err := fromSnapshotParts(
+		snapshot.Finalized,
+		snapshot.DepositCount,
+		constants.DepositContractTreeDepth,
+	)
+	if err != nil {
+		return nil, err
+	}
+	d := &DepositTree{
+		tree:                 tree,
+		mixInLength:          snapshot.DepositCount,
+		finalizedBlockHash:   snapshot.ExecutionBlockHash,
+		finalizedBlockHeight: math.U64(snapshot.ExecutionBlockHeight),
+	}
+	if d.HashTreeRoot() != snapshot.DepositRoot {
+		return nil, errors.Wrap(
+			ErrInvalidSnapshot, "finalized roots mismatch",
+		)
+	}
+	return d, nil
+}
+
+// fromSnapshotParts rebuilds a subtree of the given depth from the roots of
+// its finalized subtrees.
+func fromSnapshotParts(
+	finalized []common.Root,
+	count uint64,
+	depth uint8,
+) (treeNode, error) {
+	if len(finalized) == 0 || count == 0 {
+		return &zeroNode{depth: depth}, nil
+	}
+	if count == uint64(1)<<depth {
+		return &finalizedNode{count: count, hash: finalized[0]}, nil
+	}
+	if depth == 0 {
+		return nil, ErrInvalidSnapshot
+	}
+
+	half := uint64(1) << (depth - 1)
+	if count <= half {
+		left, err := fromSnapshotParts(finalized, count, depth-1)
+		if err != nil {
+			return nil, err
+		}
+		return &innerNode{left: left, right: &zeroNode{depth: depth - 1}}, nil
+	}
+	right, err := fromSnapshotParts(finalized[1:], count-half, depth-1)
+	if err != nil {
+		return nil, err
+	}
+	return &innerNode{
+		left:  &finalizedNode{count: half, hash: finalized[0]},
+		right: right,
+	}, nil
+}
+
+// DepositCount returns the number of deposits in the tree.
+func (d *DepositTree) DepositCount() uint64 {
+	return d.mixInLength
+}
+
+// HashTreeRoot returns the deposit root, i.e. the root of the tree with the
+// deposit count mixed in, as returned by the deposit contract.
+func (d *DepositTree) HashTreeRoot() common.Root {
+	return mixInLength(d.tree.root(), d.mixInLength)
+}
+
+// PushLeaf appends the hash tree root of a deposit data to the tree.
+func (d *DepositTree) PushLeaf(leaf common.Root) error {
+	if d.mixInLength >= uint64(1)<<constants.DepositContractTreeDepth {
+		return ErrTreeFull
+	}
+	tree, err := d.tree.pushLeaf(leaf, constants.DepositContractTreeDepth)
+	if err != nil {
+		return err
+	}
+	d.tree = tree
+	d.mixInLength++
+	return nil
+}
+
+// GetProof returns the leaf of the deposit at the given index along with its
+// Merkle branch against the current deposit root. The deposit count is mixed
+// in as the last element of the branch, so the branch verifies at a depth of
+// constants.DepositProofLength.
+func (d *DepositTree) GetProof(
+	index uint64,
+) (common.Root, []common.Root, error) {
+	if index >= d.mixInLength {
+		return common.Root{}, nil, ErrInvalidIndex
+	}
+	if finalized, _ := d.tree.getFinalized(nil); index < finalized {
+		return common.Root{}, nil, ErrFinalizedNode
+	}
+	leaf, proof, err := d.tree.getProof(
+		index, constants.DepositContractTreeDepth,
+	)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	return leaf, append(proof, lengthRoot(d.mixInLength)), nil
+}
+
+// Finalize marks the first count deposits as finalized at the given execution
+// block, after which they can no longer be proven.
+func (d *DepositTree) Finalize(
+	count uint64,
+	blockHash common.ExecutionHash,
+	blockHeight math.U64,
+) error {
+	if count > d.mixInLength {
+		return ErrInvalidFinalizeCount
+	}
+	d.tree = d.tree.finalize(count, constants.DepositContractTreeDepth)
+	d.finalizedBlockHash = blockHash
+	d.finalizedBlockHeight = blockHeight
+	return nil
+}
+
+// GetSnapshot returns a snapshot of the finalized part of the tree.
+func (d *DepositTree) GetSnapshot() *DepositTreeSnapshot {
+	count, finalized := d.tree.getFinalized(make([]common.Root, 0))
+	snapshot := &DepositTreeSnapshot{
+		Finalized:            finalized,
+		DepositCount:         count,
+		ExecutionBlockHash:   d.finalizedBlockHash,
+		ExecutionBlockHeight: d.finalizedBlockHeight.Unwrap(),
+	}
+	snapshot.DepositRoot = snapshot.CalculateRoot()
+	return snapshot
+}
@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	pmerkle "github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// generateLeaves returns n distinct deposit leaves.
+func generateLeaves(n int) []common.Root {
+	leaves := make([]common.Root, n)
+	for i := range leaves {
+		leaves[i] = sha256.Hash([]byte{byte(i), byte(i >> 8)})
+	}
+	return leaves
+}
+
+// expectedRoot computes the deposit root of the leaves with a full tree.
+func expectedRoot(t *testing.T, leaves []common.Root) common.Root {
+	t.Helper()
+	tree, err := pmerkle.NewTreeFromLeavesWithDepth(
+		leaves, constants.DepositContractTreeDepth,
+	)
+	require.NoError(t, err)
+	return tree.HashTreeRoot()
+}
+
+func TestDepositTree_HashTreeRoot(t *testing.T) {
+	leaves := generateLeaves(33)
+	tree := merkle.NewDepositTree()
+	for i, leaf := range leaves {
+		require.NoError(t, tree.PushLeaf(leaf))
+		require.Equal(t, uint64(i+1), tree.DepositCount())
+		require.Equal(t, expectedRoot(t, leaves[:i+1]), tree.HashTreeRoot())
+	}
+}
+
+func TestDepositTree_GetProof(t *testing.T) {
+	leaves := generateLeaves(19)
+	tree := merkle.NewDepositTree()
+	for _, leaf := range leaves {
+		require.NoError(t, tree.PushLeaf(leaf))
+	}
+
+	root := tree.HashTreeRoot()
+	for i, leaf := range leaves {
+		//#nosec:G701 // the number of leaves is small.
+		index := uint64(i)
+		provenLeaf, proof, err := tree.GetProof(index)
+		require.NoError(t, err)
+		require.Equal(t, leaf, provenLeaf)
+		require.Len(t, proof, int(constants.DepositProofLength))
+		require.True(t, pmerkle.IsValidMerkleBranch(
+			leaf, proof, constants.DepositProofLength, index, root,
+		))
+		// A deposit must not verify at any other position.
+		require.False(t, pmerkle.IsValidMerkleBranch(
+			leaf, proof, constants.DepositProofLength, index+1, root,
+		))
+	}
+
+	_, _, err := tree.GetProof(uint64(len(leaves)))
+	require.ErrorIs(t, err, merkle.ErrInvalidIndex)
+}
+
+func TestDepositTree_Finalize(t *testing.T) {
+	leaves := generateLeaves(21)
+	tree := merkle.NewDepositTree()
+	for _, leaf := range leaves {
+		require.NoError(t, tree.PushLeaf(leaf))
+	}
+	root := tree.HashTreeRoot()
+
+	require.ErrorIs(
+		t,
+		tree.Finalize(22, common.ExecutionHash{}, 0),
+		merkle.ErrInvalidFinalizeCount,
+	)
+	require.NoError(t, tree.Finalize(13, common.ExecutionHash{0x01}, 7))
+
+	// Finalizing does not change the root of the tree.
+	require.Equal(t, root, tree.HashTreeRoot())
+
+	// Finalized deposits can no longer be proven.
+	_, _, err := tree.GetProof(12)
+	require.ErrorIs(t, err, merkle.ErrFinalizedNode)
+
+	// The remaining deposits can still be proven.
+	for i := 13; i < len(leaves); i++ {
+		//#nosec:G701 // the number of leaves is small.
+		index := uint64(i)
+		_, proof, err := tree.GetProof(index)
+		require.NoError(t, err)
+		require.True(t, pmerkle.IsValidMerkleBranch(
+			leaves[i], proof, constants.DepositProofLength, index, root,
+		))
+	}
+
+	// The tree can still be extended.
+	leaves = append(leaves, generateLeaves(25)[21:]...)
+	for _, leaf := range leaves[21:] {
+		require.NoError(t, tree.PushLeaf(leaf))
+	}
+	require.Equal(t, expectedRoot(t, leaves), tree.HashTreeRoot())
+}
+
+func TestDepositTree_Snapshot(t *testing.T) {
+	leaves := generateLeaves(40)
+	tree := merkle.NewDepositTree()
+	for _, leaf := range leaves[:27] {
+		require.NoError(t, tree.PushLeaf(leaf))
+	}
+	require.NoError(t, tree.Finalize(27, common.ExecutionHash{0x01}, 9))
+
+	snapshot := tree.GetSnapshot()
+	require.Equal(t, uint64(27), snapshot.DepositCount)
+	require.Equal(t, expectedRoot(t, leaves[:27]), snapshot.DepositRoot)
+	require.Equal(t, common.ExecutionHash{0x01}, snapshot.ExecutionBlockHash)
+	require.Equal(t, uint64(9), snapshot.ExecutionBlockHeight)
+	// 27 = 0b11011 so there is one finalized subtree per set bit.
+	require.Len(t, snapshot.Finalized, 4)
+
+	restored, err := merkle.NewDepositTreeFromSnapshot(snapshot)
+	require.NoError(t, err)
+	require.Equal(t, tree.HashTreeRoot(), restored.HashTreeRoot())
+	require.Equal(t, snapshot, restored.GetSnapshot())
+
+	// The restored tree can be extended and proven like the original.
+	for _, leaf := range leaves[27:] {
+		require.NoError(t, tree.PushLeaf(leaf))
+		require.NoError(t, restored.PushLeaf(leaf))
+	}
+	root := expectedRoot(t, leaves)
+	require.Equal(t, root, restored.HashTreeRoot())
+	_, proof, err := restored.GetProof(30)
+	require.NoError(t, err)
+	require.True(t, pmerkle.IsValidMerkleBranch(
+		leaves[30], proof, constants.DepositProofLength, 30, root,
+	))
+}
+
+func TestDepositTree_EmptySnapshot(t *testing.T) {
+	tree := merkle.NewDepositTree()
+	restored, err := merkle.NewDepositTreeFromSnapshot(tree.GetSnapshot())
+	require.NoError(t, err)
+	require.Equal(t, tree.HashTreeRoot(), restored.HashTreeRoot())
+}
+
+func TestDepositTree_InvalidSnapshot(t *testing.T) {
+	leaves := generateLeaves(5)
+	tree := merkle.NewDepositTree()
+	for _, leaf := range leaves {
+		require.NoError(t, tree.PushLeaf(leaf))
+	}
+	require.NoError(t, tree.Finalize(5, common.ExecutionHash{}, 0))
+
+	snapshot := tree.GetSnapshot()
+	snapshot.Finalized[0] = common.Root{0x01}
+	_, err := merkle.NewDepositTreeFromSnapshot(snapshot)
+	require.ErrorIs(t, err, merkle.ErrInvalidSnapshot)
+
+	snapshot = tree.GetSnapshot()
+	snapshot.DepositCount++
+	_, err = merkle.NewDepositTreeFromSnapshot(snapshot)
+	require.ErrorIs(t, err, merkle.ErrInvalidSnapshot)
+}
+
+func TestDepositTreeSnapshot_JSON(t *testing.T) {
+	snapshot := &merkle.DepositTreeSnapshot{
+		Finalized:            []common.Root{{0x01}},
+		DepositRoot:          common.Root{0x02},
+		DepositCount:         1,
+		ExecutionBlockHash:   common.ExecutionHash{0x03},
+		ExecutionBlockHeight: 10,
+	}
+	bz, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+
+	// The snapshot is encoded in the format of the beacon API.
+	fields := make(map[string]any)
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	require.Equal(t, "1", fields["deposit_count"])
+	require.Equal(t, "10", fields["execution_block_height"])
+	require.Contains(t, fields, "finalized")
+	require.Contains(t, fields, "deposit_root")
+	require.Contains(t, fields, "execution_block_hash")
+
+	decoded := new(merkle.DepositTreeSnapshot)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	require.Equal(t, snapshot, decoded)
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrTreeFull is returned when a leaf is pushed to a full tree.
+	ErrTreeFull = errors.New("deposit tree is full")
+	// ErrInvalidIndex is returned when a proof is requested for a deposit
+	// that is not in the tree.
+	ErrInvalidIndex = errors.New("deposit index is not in the tree")
+	// ErrFinalizedNode is returned when a proof is requested for a deposit
+	// that has already been finalized, or a leaf is pushed to a finalized
+	// subtree.
+	ErrFinalizedNode = errors.New("deposit has been finalized")
+	// ErrInvalidFinalizeCount is returned when the tree is asked to finalize
+	// more deposits than it contains.
+	ErrInvalidFinalizeCount = errors.New(
+		"cannot finalize more deposits than are in the tree",
+	)
+	// ErrInvalidSnapshot is returned when a snapshot is not consistent with
+	// the deposit root it claims.
+	ErrInvalidSnapshot = errors.New("invalid deposit tree snapshot")
+)
@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// treeNode is a node of the sparse deposit Merkle tree described in EIP-4881.
+// Subtrees that have been finalized are collapsed into a single node holding
+// their root, which keeps the tree size logarithmic in the deposit count.
+type treeNode interface {
+	// root returns the root of the subtree.
+	root() common.Root
+	// isFull returns whether no more leaves can be pushed to the subtree.
+	isFull() bool
+	// pushLeaf pushes a leaf into the subtree of the given depth and returns
+	// the resulting subtree.
+	pushLeaf(leaf common.Root, depth uint8) (treeNode, error)
+	// finalize collapses the subtrees holding the first count leaves of the
+	// subtree of the given depth and returns the resulting subtree.
+	finalize(count uint64, depth uint8) treeNode
+	// getFinalized appends the roots of the finalized subtrees to result
+	// and returns the number of deposits they cover.
+	getFinalized(result []common.Root) (uint64, []common.Root)
+	// getProof returns the leaf at the given index and its Merkle branch in
+	// the subtree of the given depth.
+	getProof(index uint64, depth uint8) (common.Root, []common.Root, error)
+}
+
+// newTreeNode creates a subtree of the given depth holding the given leaves.
+func newTreeNode(leaves []common.Root, depth uint8) treeNode {
+	switch {
+	case len(leaves) == 0:
+		return &zeroNode{depth: depth}
+	case depth == 0:
+		return &leafNode{hash: leaves[0]}
+	}
+	split := min(uint64(1)<<(depth-1), uint64(len(leaves)))
+	return &innerNode{
+		left:  newTreeNode(leaves[:split], depth-1),
+		right: newTreeNode(leaves[split:], depth-1),
+	}
+}
+
+// finalizedNode is a subtree whose leaves have all been finalized.
+type finalizedNode struct {
+	count uint64
+	hash  common.Root
+}
+
+func (n *finalizedNode) root() common.Root {
+	return n.hash
+}
+
+func (n *finalizedNode) isFull() bool {
+	return true
+}
+
+func (n *finalizedNode) pushLeaf(common.Root, uint8) (treeNode, error) {
+	return nil, ErrFinalizedNode
+}
+
+func (n *finalizedNode) finalize(uint64, uint8) treeNode {
+	return n
+}
+
+func (n *finalizedNode) getFinalized(
+	result []common.Root,
+) (uint64, []common.Root) {
+	return n.count, append(result, n.hash)
+}
+
+func (n *finalizedNode) getProof(
+	uint64, uint8,
+) (common.Root, []common.Root, error) {
+	return common.Root{}, nil, ErrFinalizedNode
+}
+
+// leafNode is a single, not yet finalized, deposit.
+type leafNode struct {
+	hash common.Root
+}
+
+func (n *leafNode) root() common.Root {
+	return n.hash
+}
+
+func (n *leafNode) isFull() bool {
+	return true
+}
+
+func (n *leafNode) pushLeaf(common.Root, uint8) (treeNode, error) {
+	return nil, ErrTreeFull
+}
+
+func (n *leafNode) finalize(uint64, uint8) treeNode {
+	return &finalizedNode{count: 1, hash: n.hash}
+}
+
+func (n *leafNode) getFinalized(
+	result []common.Root,
+) (uint64, []common.Root) {
+	return 0, result
+}
+
+func (n *leafNode) getProof(
+	uint64, uint8,
+) (common.Root, []common.Root, error) {
+	return n.hash, nil, nil
+}
+
+// innerNode is a subtree with at least one deposit that is not finalized.
+type innerNode struct {
+	left  treeNode
+	right treeNode
+	// hash caches the root of the subtree, it is reset whenever a leaf is
+	// pushed to the subtree.
+	hash *common.Root
+}
+
+func (n *innerNode) root() common.Root {
+	if n.hash == nil {
+		left, right := n.left.root(), n.right.root()
+		hash := common.Root(sha256.Hash(append(left[:], right[:]...)))
+		n.hash = &hash
+	}
+	return *n.hash
+}
+
+func (n *innerNode) isFull() bool {
+	return n.right.isFull()
+}
+
+func (n *innerNode) pushLeaf(leaf common.Root, depth uint8) (treeNode, error) {
+	var err error
+	if !n.left.isFull() {
+		n.left, err = n.left.pushLeaf(leaf, depth-1)
+	} else {
+		n.right, err = n.right.pushLeaf(leaf, depth-1)
+	}
+	n.hash = nil
+	return n, err
+}
+
+func (n *innerNode) finalize(count uint64, depth uint8) treeNode {
+	deposits := uint64(1) << depth
+	if deposits <= count {
+		return &finalizedNode{count: deposits, hash: n.root()}
+	}
+	n.left = n.left.finalize(count, depth-1)
+	if half := deposits / 2; count > half {
+		n.right = n.right.finalize(count-half, depth-1)
+	}
+	return n
+}
+
+func (n *innerNode) getFinalized(
+	result []common.Root,
+) (uint64, []common.Root) {
+	leftCount, result := n.left.getFinalized(result)
+	rightCount, result := n.right.getFinalized(result)
+	return leftCount + rightCount, result
+}
+
+func (n *innerNode) getProof(
+	index uint64, depth uint8,
+) (common.Root, []common.Root, error) {
+	if depth == 0 {
+		return common.Root{}, nil, ErrInvalidIndex
+	}
+	var (
+		leaf  common.Root
+		proof []common.Root
+		err   error
+	)
+	if (index>>(depth-1))&1 == 1 {
+		leaf, proof, err = n.right.getProof(index, depth-1)
+		proof = append(proof, n.left.root())
+	} else {
+		leaf, proof, err = n.left.getProof(index, depth-1)
+		proof = append(proof, n.right.root())
+	}
+	return leaf, proof, err
+}
+
+// zeroNode is a subtree without any deposits.
+type zeroNode struct {
+	depth uint8
+}
+
+func (n *zeroNode) root() common.Root {
+	return zero.Hashes[n.depth]
+}
+
+func (n *zeroNode) isFull() bool {
+	return false
+}
+
+func (n *zeroNode) pushLeaf(leaf common.Root, depth uint8) (treeNode, error) {
+	return newTreeNode([]common.Root{leaf}, depth), nil
+}
+
+func (n *zeroNode) finalize(uint64, uint8) treeNode {
+	return n
+}
+
+func (n *zeroNode) getFinalized(
+	result []common.Root,
+) (uint64, []common.Root) {
+	return 0, result
+}
+
+func (n *zeroNode) getProof(
+	_ uint64, depth uint8,
+) (common.Root, []common.Root, error) {
+	proof := make([]common.Root, depth)
+	for i := range depth {
+		proof[i] = zero.Hashes[i]
+	}
+	return zero.Hashes[0], proof, nil
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// DepositTreeSnapshot is the minimal representation of a deposit tree from
+// which it can be rebuilt, as specified in EIP-4881. It contains the roots of
+// the finalized subtrees along with the execution block at which the tree
+// was finalized. Its JSON encoding is the one served by the deposit snapshot
+// endpoint of the beacon API.
+type DepositTreeSnapshot struct {
+	// Finalized are the roots of the finalized subtrees, ordered from left
+	// to right.
+	Finalized []common.Root `json:"finalized"`
+	// DepositRoot is the deposit root of the finalized deposits.
+	DepositRoot common.Root `json:"deposit_root"`
+	// DepositCount is the number of finalized deposits.
+	DepositCount uint64 `json:"deposit_count,string"`
+	// ExecutionBlockHash is the hash of the execution block at which the
+	// deposits were finalized.
+	ExecutionBlockHash common.ExecutionHash `json:"execution_block_hash"`
+	// ExecutionBlockHeight is the number of the execution block at which the
+	// deposits were finalized.
+	ExecutionBlockHeight uint64 `json:"execution_block_height,string"`
+}
+
+// CalculateRoot computes the deposit root from the finalized subtrees of the
+// snapshot.
+func (s *DepositTreeSnapshot) CalculateRoot() common.Root {
+	var (
+		size  = s.DepositCount
+		index = len(s.Finalized)
+		root  = common.Root(zero.Hashes[0])
+	)
+	for i := range constants.DepositContractTreeDepth {
+		if size&1 == 1 {
+			if index == 0 {
+				// Not enough finalized roots for the deposit count, the
+				// result will not match any valid deposit root.
+				return common.Root{}
+			}
+			index--
+			root = hashPair(s.Finalized[index], root)
+		} else {
+			root = hashPair(root, zero.Hashes[i])
+		}
+		size >>= 1
+	}
+	return mixInLength(root, s.DepositCount)
+}
+
+// hashPair returns the hash of the concatenation of two roots.
+func hashPair(a, b common.Root) common.Root {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return sha256.Hash(buf[:])
+}
+
+// lengthRoot returns the deposit count as a little endian encoded root.
+func lengthRoot(length uint64) common.Root {
+	var root common.Root
+	binary.LittleEndian.PutUint64(root[:8], length)
+	return root
+}
+
+// mixInLength mixes the deposit count into the root of the deposit tree.
+func mixInLength(root common.Root, length uint64) common.Root {
+	return hashPair(root, lengthRoot(length))
+}
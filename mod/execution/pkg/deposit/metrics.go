@@ -20,11 +20,7 @@
 
 package deposit
 
-import (
-	"strconv"
-
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
-)
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 
 // metrics is a struct that contains metrics for the deposit service.
 type metrics struct {
@@ -39,11 +35,38 @@ func newMetrics(sink TelemetrySink) *metrics {
 	}
 }
 
-// markFailedToGetBlockLogs increments the counter for failed to get block logs.
-func (m *metrics) markFailedToGetBlockLogs(blockNum math.U64) {
+// markFailedToGetLogs increments the counter for failed to get logs.
+func (m *metrics) markFailedToGetLogs() {
 	m.sink.IncrementCounter(
-		"beacon_kit.execution.deposit.failed_to_get_block_logs",
-		"block_num",
-		strconv.FormatUint(blockNum.Unwrap(), 10),
+		"beacon_kit.execution.deposit.failed_to_get_logs",
+	)
+}
+
+// setLastScannedBlock sets the gauge for the last scanned execution block.
+func (m *metrics) setLastScannedBlock(number math.U64) {
+	//#nosec:G701 // block numbers never exceed the int64 range.
+	m.sink.SetGauge(
+		"beacon_kit.execution.deposit.last_scanned_block",
+		int64(number.Unwrap()),
+	)
+}
+
+// setTargetBlock sets the gauge for the execution block up to which the
+// deposit logs are to be scanned.
+func (m *metrics) setTargetBlock(number math.U64) {
+	//#nosec:G701 // block numbers never exceed the int64 range.
+	m.sink.SetGauge(
+		"beacon_kit.execution.deposit.target_block",
+		int64(number.Unwrap()),
+	)
+}
+
+// setBatchSize sets the gauge for the number of execution blocks scanned at
+// once.
+func (m *metrics) setBatchSize(batchSize uint64) {
+	//#nosec:G701 // the batch size never exceeds the int64 range.
+	m.sink.SetGauge(
+		"beacon_kit.execution.deposit.batch_size",
+		int64(batchSize),
 	)
 }
@@ -26,12 +26,15 @@ import (
 )
 
 func BuildPruneRangeFn[
-	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
-	BeaconBlockT BeaconBlock[DepositT, BeaconBlockBodyT, ExecutionPayloadT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, Eth1DataT, ExecutionPayloadT],
+	BeaconBlockT BeaconBlock[
+		DepositT, BeaconBlockBodyT, Eth1DataT, ExecutionPayloadT,
+	],
 	BlockEventT BlockEvent[
-		DepositT, BeaconBlockBodyT, BeaconBlockT, ExecutionPayloadT,
+		DepositT, BeaconBlockBodyT, BeaconBlockT, Eth1DataT, ExecutionPayloadT,
 	],
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
+	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT ExecutionPayload,
 	WithdrawalCredentialsT any,
 ](cs common.ChainSpec) func(BlockEventT) (uint64, uint64) {
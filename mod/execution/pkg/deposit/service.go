@@ -22,22 +22,49 @@ package deposit
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit/merkle"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	lru "github.com/hashicorp/golang-lru/v2"
 )
 
+// eth1BlocksCacheSize is the number of recently read execution blocks that
+// are remembered.
+const eth1BlocksCacheSize = 256
+
+// eth1Block is an execution block whose deposits have been pushed onto the
+// deposit tree.
+type eth1Block struct {
+	// number is the number of the block.
+	number math.U64
+	// depositCount is the number of deposits up to and including the block.
+	depositCount math.U64
+	// depositRoot is the root of the deposit tree at the block.
+	depositRoot common.Root
+}
+
 // Service represents the deposit service that processes deposit events.
 type Service[
-	BeaconBlockT BeaconBlock[DepositT, BeaconBlockBodyT, ExecutionPayloadT],
-	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
+	BeaconBlockT BeaconBlock[
+		DepositT, BeaconBlockBodyT, Eth1DataT, ExecutionPayloadT,
+	],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, Eth1DataT, ExecutionPayloadT],
 	BlockEventT BlockEvent[
-		DepositT, BeaconBlockBodyT, BeaconBlockT, ExecutionPayloadT,
+		DepositT, BeaconBlockBodyT, BeaconBlockT, Eth1DataT, ExecutionPayloadT,
 	],
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
+	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT ExecutionPayload,
+	GenesisT Genesis[DepositT],
 	WithdrawalCredentialsT any,
 ] struct {
+	// cfg is the configuration for the deposit service.
+	cfg Config
 	// logger is used for logging information and errors.
 	logger log.Logger[any]
 	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
@@ -46,65 +73,108 @@ type Service[
 	dc Contract[DepositT]
 	// ds is the deposit store that stores deposits.
 	ds Store[DepositT]
-	// feed is the block feed that provides block events.
+	// feed is the block feed that provides finalized block events.
 	feed chan BlockEventT
+	// genesisFeed is the feed that provides the genesis data, whose
+	// deposits are the first leaves of the deposit tree.
+	genesisFeed chan *asynctypes.Event[GenesisT]
+	// mu protects the deposit tree.
+	mu sync.Mutex
+	// tree is the deposit Merkle tree, which holds every deposit in the
+	// deposit store that has not been finalized.
+	tree *merkle.DepositTree
+	// eth1BlockHash is the hash of the latest execution block whose
+	// deposits have been pushed onto the deposit tree.
+	eth1BlockHash common.ExecutionHash
+	// eth1BlockNumber is the number of the latest execution block whose
+	// deposits have been pushed onto the deposit tree.
+	eth1BlockNumber math.U64
+	// eth1Blocks maps the hashes of recently read execution blocks to their
+	// numbers and the state of the deposit tree at them, such that the eth1
+	// data of incoming blocks can be verified and the tree can be finalized
+	// at the block referenced by the eth1 data of a finalized beacon block.
+	eth1Blocks *lru.Cache[common.ExecutionHash, eth1Block]
+	// lastScannedBlock is the number of the last execution block whose
+	// deposit logs have been scanned. It is protected by mu, as the deposit
+	// store may be restored while the deposit syncer is running.
+	lastScannedBlock math.U64
+	// targetBlock is the number of the execution block up to which the
+	// deposit logs are to be scanned.
+	targetBlock atomic.Uint64
+	// targetUpdated notifies the deposit syncer of a new target block.
+	targetUpdated chan struct{}
 	// metrics is the metrics for the deposit service.
 	metrics *metrics
-	// failedBlocks is a map of blocks that failed to be processed to be
-	// retried.
-	failedBlocks map[math.U64]struct{}
 }
 
 // NewService creates a new instance of the Service struct.
 func NewService[
-	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
-	BeaconBlockT BeaconBlock[DepositT, BeaconBlockBodyT, ExecutionPayloadT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, Eth1DataT, ExecutionPayloadT],
+	BeaconBlockT BeaconBlock[
+		DepositT, BeaconBlockBodyT, Eth1DataT, ExecutionPayloadT,
+	],
 	BlockEventT BlockEvent[
 		DepositT, BeaconBlockBodyT,
-		BeaconBlockT, ExecutionPayloadT,
+		BeaconBlockT, Eth1DataT, ExecutionPayloadT,
 	],
 	DepositStoreT Store[DepositT],
+	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT ExecutionPayload,
+	GenesisT Genesis[DepositT],
 	WithdrawalCredentialsT any,
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
 ](
+	cfg Config,
 	logger log.Logger[any],
 	eth1FollowDistance math.U64,
 	telemetrySink TelemetrySink,
 	ds Store[DepositT],
 	dc Contract[DepositT],
 	feed chan BlockEventT,
+	genesisFeed chan *asynctypes.Event[GenesisT],
 ) *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT, DepositT,
-	ExecutionPayloadT, WithdrawalCredentialsT,
+	Eth1DataT, ExecutionPayloadT, GenesisT, WithdrawalCredentialsT,
 ] {
+	// The cache size is a constant greater than zero, so this never errors.
+	eth1Blocks, _ := lru.New[common.ExecutionHash, eth1Block](
+		eth1BlocksCacheSize,
+	)
 	return &Service[
 		BeaconBlockT, BeaconBlockBodyT, BlockEventT, DepositT,
-		ExecutionPayloadT,
+		Eth1DataT, ExecutionPayloadT, GenesisT,
 		WithdrawalCredentialsT,
 	]{
+		cfg:                cfg,
 		feed:               feed,
+		genesisFeed:        genesisFeed,
+		tree:               merkle.NewDepositTree(),
+		eth1Blocks:         eth1Blocks,
 		logger:             logger,
 		eth1FollowDistance: eth1FollowDistance,
 		metrics:            newMetrics(telemetrySink),
 		dc:                 dc,
 		ds:                 ds,
-		failedBlocks:       make(map[math.Slot]struct{}),
+		targetUpdated:      make(chan struct{}, 1),
 	}
 }
 
 // Start starts the service and begins processing block events.
 func (s *Service[
-	_, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
+	if err := s.loadDepositTree(ctx); err != nil {
+		return err
+	}
+	go s.genesisHandler(ctx)
 	go s.depositFetcher(ctx)
-	go s.depositCatchupFetcher(ctx)
+	go s.depositSyncer(ctx)
 	return nil
 }
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "deposit-handler"
 }
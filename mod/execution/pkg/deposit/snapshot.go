@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+const (
+	// snapshotName is the name of the state sync snapshot extension of the
+	// deposit store.
+	snapshotName = "deposits"
+	// snapshotFormat is the format of the snapshot extension, in which every
+	// payload is an entry of the deposit store.
+	snapshotFormat uint32 = 1
+)
+
+// SnapshotName returns the name of the state sync snapshot extension that
+// carries the deposit store, which lives outside of the multistore.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) SnapshotName() string {
+	return snapshotName
+}
+
+// SnapshotFormat returns the format in which the deposit store is written to
+// the state sync snapshots.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) SnapshotFormat() uint32 {
+	return snapshotFormat
+}
+
+// SupportedFormats returns the formats from which the deposit store can be
+// restored.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) SupportedFormats() []uint32 {
+	return []uint32{snapshotFormat}
+}
+
+// SnapshotExtension writes the entries of the deposit store to the state
+// sync snapshot taken at the given height.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) SnapshotExtension(_ uint64, write func([]byte) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ds.Export(write)
+}
+
+// RestoreExtension restores the deposit store from a state sync snapshot and
+// rebuilds the deposit tree from it. A node that state syncs never processes
+// the genesis deposits nor the deposits finalized before the snapshot, so
+// these must come with the snapshot.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) RestoreExtension(
+	_ uint64,
+	format uint32,
+	read func() ([]byte, error),
+) error {
+	if format != snapshotFormat {
+		return errors.Wrapf(ErrUnknownSnapshotFormat, "format %d", format)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ds.Import(read); err != nil {
+		return err
+	}
+	s.tree = merkle.NewDepositTree()
+	s.eth1Blocks.Purge()
+	s.eth1BlockHash, s.eth1BlockNumber = common.ExecutionHash{}, 0
+	if err := s.buildDepositTree(context.Background()); err != nil {
+		return err
+	}
+	s.metrics.setLastScannedBlock(s.lastScannedBlock)
+	return nil
+}
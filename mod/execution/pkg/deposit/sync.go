@@ -24,82 +24,153 @@ import (
 	"context"
 	"time"
 
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
-
-// depositFetcher processes a deposit event.
+// depositFetcher finalizes the deposit tree with the finalized blocks and
+// advances the target block of the deposit syncer accordingly.
 func (s *Service[
-	_, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
 ]) depositFetcher(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case msg := <-s.feed:
-			if msg.Is(events.BeaconBlockFinalized) {
-				blockNum := msg.Data().
-					GetBody().GetExecutionPayload().GetNumber()
-				s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+			s.finalizeDepositTree(msg.Data())
+			blockNum := msg.Data().
+				GetBody().GetExecutionPayload().GetNumber()
+			if blockNum > s.eth1FollowDistance {
+				s.setTargetBlock(blockNum - s.eth1FollowDistance)
 			}
 		}
 	}
 }
 
-// depositCatchupFetcher fetches deposits for blocks that failed to be
-// processed.
+// setTargetBlock sets the execution block up to which the deposit logs are
+// to be scanned and wakes up the deposit syncer.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) setTargetBlock(number math.U64) {
+	s.targetBlock.Store(number.Unwrap())
+	s.metrics.setTargetBlock(number)
+	select {
+	case s.targetUpdated <- struct{}{}:
+	default:
+	}
+}
+
+// depositSyncer scans the deposit logs in batches from the block following
+// the last scanned block up to the target block. A failed scan is retried
+// with an exponential backoff and a halved batch size, which is grown back
+// to the configured size once scans succeed again.
 func (s *Service[
-	_, _, _, _, _, _,
-]) depositCatchupFetcher(ctx context.Context) {
-	ticker := time.NewTicker(defaultRetryInterval)
-	defer ticker.Stop()
+	_, _, _, _, _, _, _, _,
+]) depositSyncer(ctx context.Context) {
+	var (
+		batchSize     = max(s.cfg.BatchSize, 1)
+		retryInterval = s.cfg.RetryInterval
+	)
+	s.metrics.setBatchSize(batchSize)
+	s.metrics.setLastScannedBlock(s.getLastScannedBlock())
 	for {
-		select {
-		case <-ctx.Done():
+		if ctx.Err() != nil {
 			return
-		case <-ticker.C:
-			if len(s.failedBlocks) == 0 {
+		}
+
+		lastScannedBlock := s.getLastScannedBlock()
+		target := math.U64(s.targetBlock.Load())
+		if lastScannedBlock >= target {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.targetUpdated:
 				continue
 			}
+		}
+
+		from := lastScannedBlock + 1
+		to := min(lastScannedBlock+math.U64(batchSize), target)
+		if err := s.scanDeposits(ctx, from, to); err != nil {
+			s.metrics.markFailedToGetLogs()
 			s.logger.Warn(
-				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				s.failedBlocks,
+				"Failed to scan deposit logs, retrying...",
+				"from", from, "to", to, "retry_in", retryInterval,
+				"error", err,
 			)
-
-			// Fetch deposits for blocks that failed to be processed.
-			for blockNum := range s.failedBlocks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
+			batchSize = max(batchSize/2, 1)
+			s.metrics.setBatchSize(batchSize)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryInterval):
 			}
+			retryInterval = min(2*retryInterval, s.cfg.MaxRetryInterval)
+			continue
+		}
+
+		retryInterval = s.cfg.RetryInterval
+		if batchSize < s.cfg.BatchSize {
+			batchSize = min(2*batchSize, s.cfg.BatchSize)
+			s.metrics.setBatchSize(batchSize)
 		}
 	}
 }
 
+// scanDeposits reads the deposits of the blocks in the inclusive range
+// [from, to], stores them and pushes them onto the deposit tree. The last
+// scanned block is only advanced once the deposits are stored, such that
+// no deposit is missed across restarts.
 func (s *Service[
-	_, _, _, _, _, _,
-]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
-	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
+	_, _, _, _, _, _, _, _,
+]) scanDeposits(ctx context.Context, from, to math.U64) error {
+	deposits, blockHash, err := s.dc.ReadDeposits(ctx, from, to)
 	if err != nil {
-		s.metrics.markFailedToGetBlockLogs(blockNum)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
+		return err
 	}
 
 	if len(deposits) > 0 {
 		s.logger.Info(
 			"Found deposits on execution layer",
-			"block", blockNum, "deposits", len(deposits),
+			"from", from, "to", to, "deposits", len(deposits),
 		)
 	}
 
+	// The deposits are stored under the lock, such that the deposit tree is
+	// not extended with them before the block is recorded.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The deposit store may have been restored from a state sync snapshot
+	// during the scan, in which case the scanned range is stale.
+	if from != s.lastScannedBlock+1 {
+		return nil
+	}
 	if err = s.ds.EnqueueDeposits(deposits); err != nil {
-		s.logger.Error("Failed to store deposits", "error", err)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
+		return err
 	}
+	if err = s.extendDepositTree(); err != nil {
+		return err
+	}
+	if err = s.ds.SetLastScannedBlock(to.Unwrap()); err != nil {
+		return err
+	}
+	s.lastScannedBlock = to
+	s.eth1Blocks.Add(blockHash, eth1Block{
+		number:       to,
+		depositCount: math.U64(s.tree.DepositCount()),
+		depositRoot:  s.tree.HashTreeRoot(),
+	})
+	s.eth1BlockHash, s.eth1BlockNumber = blockHash, to
+	s.metrics.setLastScannedBlock(to)
+	return nil
+}
 
-	delete(s.failedBlocks, blockNum)
+// getLastScannedBlock returns the number of the last execution block whose
+// deposit logs have been scanned.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) getLastScannedBlock() math.U64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastScannedBlock
 }
@@ -0,0 +1,362 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// extendBatchSize is the number of deposits read from the deposit store at
+// a time when extending the deposit tree.
+const extendBatchSize = 256
+
+// GetDepositsWithProofs returns up to `numView` deposits starting at the
+// given index, along with their Merkle branches against the deposit root of
+// the returned eth1 data. The eth1 data refers to the latest execution block
+// whose deposits have been pushed onto the deposit tree and which is at least
+// the eth1 follow distance behind the given execution block.
+func (s *Service[
+	_, _, _, DepositT, Eth1DataT, _, _, _,
+]) GetDepositsWithProofs(
+	startIndex uint64,
+	numView uint64,
+	blockNumber math.U64,
+) ([]DepositT, [][]common.Root, Eth1DataT, error) {
+	var eth1Data Eth1DataT
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.extendDepositTree(); err != nil {
+		return nil, nil, eth1Data, err
+	}
+
+	tree, hash, err := s.followedDepositTree(blockNumber)
+	if err != nil {
+		return nil, nil, eth1Data, err
+	}
+	count := tree.DepositCount()
+	eth1Data = eth1Data.New(tree.HashTreeRoot(), math.U64(count), hash)
+	if startIndex >= count {
+		return []DepositT{}, [][]common.Root{}, eth1Data, nil
+	}
+
+	deposits, err := s.ds.GetDepositsByIndex(
+		startIndex, min(numView, count-startIndex),
+	)
+	if err != nil {
+		return nil, nil, eth1Data, err
+	}
+	proofs := make([][]common.Root, len(deposits))
+	for i, deposit := range deposits {
+		_, proofs[i], err = tree.GetProof(deposit.GetIndex().Unwrap())
+		if err != nil {
+			return nil, nil, eth1Data, err
+		}
+	}
+	return deposits, proofs, eth1Data, nil
+}
+
+// followedDepositTree returns the deposit tree at the latest execution block
+// whose deposits have been pushed onto the deposit tree and which is at least
+// the eth1 follow distance behind the given execution block, along with the
+// hash of that block. It must be called with the lock held.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) followedDepositTree(
+	blockNumber math.U64,
+) (*merkle.DepositTree, common.ExecutionHash, error) {
+	var limit math.U64
+	if blockNumber > s.eth1FollowDistance {
+		limit = blockNumber - s.eth1FollowDistance
+	}
+	if s.eth1BlockNumber <= limit {
+		return s.tree, s.eth1BlockHash, nil
+	}
+
+	// The deposit syncer is ahead of the follow distance, so fall back to
+	// the latest execution block read before it.
+	var (
+		hash  common.ExecutionHash
+		block eth1Block
+		found bool
+	)
+	for _, h := range s.eth1Blocks.Keys() {
+		b, ok := s.eth1Blocks.Peek(h)
+		if ok && b.number <= limit && (!found || b.number > block.number) {
+			hash, block, found = h, b, true
+		}
+	}
+	if !found {
+		return nil, common.ExecutionHash{}, errors.Wrapf(
+			ErrNoFollowedEth1Block,
+			"execution block %d, follow distance %d",
+			blockNumber, s.eth1FollowDistance,
+		)
+	}
+
+	// Rebuild the deposit tree at that block from its finalized part and
+	// the deposits in the deposit store.
+	tree, err := merkle.NewDepositTreeFromSnapshot(s.tree.GetSnapshot())
+	if err != nil {
+		return nil, common.ExecutionHash{}, err
+	}
+	count := block.depositCount.Unwrap()
+	if tree.DepositCount() < count {
+		deposits, err := s.ds.GetDepositsByIndex(
+			tree.DepositCount(), count-tree.DepositCount(),
+		)
+		if err != nil {
+			return nil, common.ExecutionHash{}, err
+		}
+		for _, deposit := range deposits {
+			if err = tree.PushLeaf(deposit.GetDepositDataRoot()); err != nil {
+				return nil, common.ExecutionHash{}, err
+			}
+		}
+	}
+	if tree.DepositCount() != count ||
+		tree.HashTreeRoot() != block.depositRoot {
+		return nil, common.ExecutionHash{}, errors.Wrapf(
+			ErrNoFollowedEth1Block,
+			"cannot rebuild deposit tree at execution block %d", block.number,
+		)
+	}
+	return tree, hash, nil
+}
+
+// VerifyEth1Data verifies that the eth1 data of the given block matches the
+// local deposit tree at the execution block it refers to, such that a
+// proposer cannot make up deposits. As the execution blocks read by the
+// deposit syncer differ from node to node, the eth1 data of an execution
+// block that is unknown locally is accepted, leaving its validation to the
+// state transition.
+func (s *Service[
+	BeaconBlockT, _, _, _, _, _, _, _,
+]) VerifyEth1Data(blk BeaconBlockT) error {
+	eth1Data := blk.GetBody().GetEth1Data()
+	block, ok := s.eth1Blocks.Get(eth1Data.GetBlockHash())
+	if !ok {
+		s.logger.Debug(
+			"Skipping eth1 data verification of unknown execution block",
+			"block_hash", eth1Data.GetBlockHash(),
+		)
+		return nil
+	}
+
+	if eth1Data.GetDepositCount() != block.depositCount ||
+		eth1Data.GetDepositRoot() != block.depositRoot {
+		return errors.Wrapf(
+			ErrEth1DataMismatch,
+			"block %d: expected deposit count %d and root %s, "+
+				"got deposit count %d and root %s",
+			block.number, block.depositCount, block.depositRoot,
+			eth1Data.GetDepositCount(), eth1Data.GetDepositRoot(),
+		)
+	}
+	return nil
+}
+
+// loadDepositTree restores the deposit tree from the persisted snapshot, or
+// bootstraps it from the configured snapshot if none has been persisted yet,
+// and extends it with the deposits in the deposit store.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) loadDepositTree(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.buildDepositTree(ctx)
+}
+
+// buildDepositTree builds the deposit tree as described in loadDepositTree.
+// It must be called with the lock held.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) buildDepositTree(ctx context.Context) error {
+	lastScannedBlock, err := s.ds.GetLastScannedBlock()
+	if err != nil {
+		return err
+	}
+	s.lastScannedBlock = math.U64(lastScannedBlock)
+
+	bz, err := s.ds.GetTreeSnapshot()
+	if err != nil {
+		return err
+	}
+
+	var snapshot *merkle.DepositTreeSnapshot
+	if bz != nil {
+		snapshot = new(merkle.DepositTreeSnapshot)
+		if err = json.Unmarshal(bz, snapshot); err != nil {
+			return err
+		}
+	} else if snapshot, err = s.bootstrapSnapshot(ctx); err != nil {
+		return err
+	}
+
+	if snapshot != nil {
+		if s.tree, err = merkle.NewDepositTreeFromSnapshot(
+			snapshot,
+		); err != nil {
+			return err
+		}
+		if bz == nil {
+			if err = s.persistDepositTree(); err != nil {
+				return err
+			}
+			s.logger.Info(
+				"Bootstrapped deposit tree from snapshot",
+				"deposit_count", snapshot.DepositCount,
+				"execution_block_height", snapshot.ExecutionBlockHeight,
+			)
+		}
+		// The deposits of the blocks up to the finalized block are
+		// accounted for by the snapshot.
+		height := math.U64(snapshot.ExecutionBlockHeight)
+		s.setFinalizedBlock(snapshot.ExecutionBlockHash, eth1Block{
+			number:       height,
+			depositCount: math.U64(snapshot.DepositCount),
+			depositRoot:  snapshot.DepositRoot,
+		})
+		s.lastScannedBlock = max(s.lastScannedBlock, height)
+	}
+	return s.extendDepositTree()
+}
+
+// persistDepositTree persists the snapshot of the deposit tree. It must be
+// called with the lock held.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) persistDepositTree() error {
+	bz, err := json.Marshal(s.tree.GetSnapshot())
+	if err != nil {
+		return err
+	}
+	return s.ds.SetTreeSnapshot(bz)
+}
+
+// setFinalizedBlock records the execution block at which the deposit tree
+// was finalized, whose deposits and those of the blocks before it are
+// accounted for by the tree. It must be called with the lock held.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) setFinalizedBlock(hash common.ExecutionHash, block eth1Block) {
+	s.eth1Blocks.Add(hash, block)
+	if block.number > s.eth1BlockNumber {
+		s.eth1BlockHash, s.eth1BlockNumber = hash, block.number
+	}
+}
+
+// extendDepositTree pushes the deposits following the last deposit of the
+// tree from the deposit store onto the tree, until the first deposit that
+// has not been stored yet. It must be called with the lock held.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) extendDepositTree() error {
+	for {
+		deposits, err := s.ds.GetDepositsByIndex(
+			s.tree.DepositCount(), extendBatchSize,
+		)
+		if err != nil {
+			return err
+		}
+		for _, deposit := range deposits {
+			if err = s.tree.PushLeaf(deposit.GetDepositDataRoot()); err != nil {
+				return err
+			}
+		}
+		if len(deposits) < extendBatchSize {
+			return nil
+		}
+	}
+}
+
+// finalizeDepositTree finalizes the deposits included in a finalized block,
+// such that they are pruned from the deposit tree, and persists the
+// resulting snapshot. The tree is only finalized once every deposit counted
+// in the eth1 data of the block has been included, since the proofs of
+// pending deposits are still needed.
+func (s *Service[
+	BeaconBlockT, _, _, _, _, _, _, _,
+]) finalizeDepositTree(blk BeaconBlockT) {
+	deposits := blk.GetBody().GetDeposits()
+	if len(deposits) == 0 {
+		return
+	}
+	eth1Data := blk.GetBody().GetEth1Data()
+	count := deposits[len(deposits)-1].GetIndex() + 1
+	if count != eth1Data.GetDepositCount() {
+		return
+	}
+
+	// The execution block of the eth1 data must be known, as the snapshot
+	// records its number.
+	block, ok := s.eth1Blocks.Get(eth1Data.GetBlockHash())
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.tree.Finalize(
+		count.Unwrap(), eth1Data.GetBlockHash(), block.number,
+	); err != nil {
+		s.logger.Error("Failed to finalize deposit tree", "error", err)
+		return
+	}
+	s.setFinalizedBlock(eth1Data.GetBlockHash(), block)
+	if err := s.persistDepositTree(); err != nil {
+		s.logger.Error("Failed to persist deposit tree snapshot", "error", err)
+	}
+}
+
+// genesisHandler stores the genesis deposits, which precede the deposits
+// of the deposit contract, and pushes them onto the deposit tree.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) genesisHandler(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-s.genesisFeed:
+			if !msg.Is(events.GenesisDataProcessRequest) {
+				continue
+			}
+			if err := s.ds.EnqueueDeposits(
+				msg.Data().GetDeposits(),
+			); err != nil {
+				s.logger.Error("Failed to store genesis deposits", "error", err)
+				continue
+			}
+			s.mu.Lock()
+			if err := s.extendDepositTree(); err != nil {
+				s.logger.Error("Failed to extend deposit tree", "error", err)
+			}
+			s.mu.Unlock()
+		}
+	}
+}
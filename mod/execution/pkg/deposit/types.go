@@ -24,22 +24,27 @@ import (
 	"context"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// BeaconBlockBody is an interface for beacon block bodies.
 type BeaconBlockBody[
 	DepositT any,
+	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT ExecutionPayload,
 ] interface {
 	GetDeposits() []DepositT
+	GetEth1Data() Eth1DataT
 	GetExecutionPayload() ExecutionPayloadT
 }
 
 // BeaconBlock is an interface for beacon blocks.
 type BeaconBlock[
 	DepositT any,
-	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, Eth1DataT, ExecutionPayloadT],
+	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT ExecutionPayload,
 ] interface {
 	GetSlot() math.U64
@@ -49,8 +54,11 @@ type BeaconBlock[
 // BlockEvent is an interface for block events.
 type BlockEvent[
 	DepositT any,
-	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
-	BeaconBlockT BeaconBlock[DepositT, BeaconBlockBodyT, ExecutionPayloadT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, Eth1DataT, ExecutionPayloadT],
+	BeaconBlockT BeaconBlock[
+		DepositT, BeaconBlockBodyT, Eth1DataT, ExecutionPayloadT,
+	],
+	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT ExecutionPayload,
 ] interface {
 	Type() asynctypes.EventID
@@ -58,6 +66,23 @@ type BlockEvent[
 	Data() BeaconBlockT
 }
 
+// Eth1Data is an interface for the eth1 data of a beacon block.
+type Eth1Data[Eth1DataT any] interface {
+	// New creates a new eth1 data.
+	New(
+		depositRoot common.Root,
+		depositCount math.U64,
+		blockHash common.ExecutionHash,
+	) Eth1DataT
+	// GetDepositCount returns the number of deposits in the deposit tree.
+	GetDepositCount() math.U64
+	// GetDepositRoot returns the root of the deposit tree.
+	GetDepositRoot() common.Root
+	// GetBlockHash returns the hash of the execution block the eth1 data
+	// refers to.
+	GetBlockHash() common.ExecutionHash
+}
+
 // ExecutionPayload is an interface for execution payloads.
 type ExecutionPayload interface {
 	GetNumber() math.U64
@@ -65,11 +90,13 @@ type ExecutionPayload interface {
 
 // Contract is the ABI for the deposit contract.
 type Contract[DepositT any] interface {
-	// ReadDeposits reads deposits from the deposit contract.
+	// ReadDeposits reads the deposits of the blocks in the inclusive range
+	// [from, to] from the deposit contract, along with the hash of the last
+	// block of the range.
 	ReadDeposits(
 		ctx context.Context,
-		blockNumber math.U64,
-	) ([]DepositT, error)
+		from, to math.U64,
+	) ([]DepositT, common.ExecutionHash, error)
 }
 
 // Deposit is an interface for deposits.
@@ -84,6 +111,15 @@ type Deposit[DepositT, WithdrawalCredentialsT any] interface {
 	) DepositT
 	// GetIndex returns the index of the deposit.
 	GetIndex() math.U64
+	// GetDepositDataRoot returns the leaf of the deposit in the deposit
+	// tree.
+	GetDepositDataRoot() common.Root
+}
+
+// Genesis is an interface for the genesis data.
+type Genesis[DepositT any] interface {
+	// GetDeposits returns the genesis deposits.
+	GetDeposits() []DepositT
 }
 
 // Store defines the interface for managing deposit operations.
@@ -92,6 +128,25 @@ type Store[DepositT any] interface {
 	Prune(index uint64, numPrune uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// GetDepositsByIndex returns up to `numView` contiguous deposits
+	// starting at the given index.
+	GetDepositsByIndex(startIndex uint64, numView uint64) ([]DepositT, error)
+	// GetTreeSnapshot returns the persisted deposit tree snapshot, or nil
+	// if none has been persisted.
+	GetTreeSnapshot() ([]byte, error)
+	// SetTreeSnapshot persists the deposit tree snapshot.
+	SetTreeSnapshot(snapshot []byte) error
+	// GetLastScannedBlock returns the number of the last execution block
+	// whose deposit logs have been scanned, or 0 if none has been scanned.
+	GetLastScannedBlock() (uint64, error)
+	// SetLastScannedBlock persists the number of the last execution block
+	// whose deposit logs have been scanned.
+	SetLastScannedBlock(number uint64) error
+	// Export writes every entry of the deposit store.
+	Export(write func([]byte) error) error
+	// Import replaces the contents of the deposit store with the entries
+	// read until io.EOF, as written by Export.
+	Import(read func() ([]byte, error)) error
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -99,4 +154,7 @@ type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
 	// keys.
 	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 }
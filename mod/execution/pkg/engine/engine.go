@@ -50,6 +50,12 @@ type Engine[
 	// ec is the engine client that the engine will use to
 	// interact with the execution layer.
 	ec *client.EngineClient[ExecutionPayloadT, PayloadAttributesT]
+	// verifiers are the additional engine clients that new payloads are
+	// verified against.
+	verifiers []*client.EngineClient[ExecutionPayloadT, PayloadAttributesT]
+	// quorum is the number of engine clients, out of ec and the verifiers,
+	// that must deem a new payload VALID. Zero disables the verification.
+	quorum uint64
 	// logger is the logger for the engine.
 	logger log.Logger[any]
 	// metrics is the metrics for the engine.
@@ -69,6 +75,8 @@ func New[
 	},
 ](
 	ec *client.EngineClient[ExecutionPayloadT, PayloadAttributesT],
+	verifiers []*client.EngineClient[ExecutionPayloadT, PayloadAttributesT],
+	quorum uint64,
 	logger log.Logger[any],
 	statusPublisher *broker.Broker[*asynctypes.Event[*service.StatusEvent]],
 	telemtrySink TelemetrySink,
@@ -81,6 +89,8 @@ func New[
 		WithdrawalsT,
 	]{
 		ec:              ec,
+		verifiers:       verifiers,
+		quorum:          quorum,
 		logger:          logger,
 		metrics:         newEngineMetrics(telemtrySink, logger),
 		statusPublisher: statusPublisher,
@@ -97,6 +107,13 @@ func (ee *Engine[_, _, _, _]) Start(
 			panic(err)
 		}
 	}()
+	for _, verifier := range ee.verifiers {
+		go func() {
+			if err := verifier.Start(ctx); err != nil {
+				ee.logger.Error("Failed to start verifier", "err", err)
+			}
+		}()
+	}
 	return nil
 }
 
@@ -124,6 +141,9 @@ func (ee *Engine[
 	hasPayloadAttributes := !req.PayloadAttributes.IsNil()
 	ee.metrics.markNotifyForkchoiceUpdateCalled(hasPayloadAttributes)
 
+	// Keep the verifiers following the chain.
+	ee.notifyVerifiersForkchoiceUpdate(ctx, req)
+
 	// Notify the execution engine of the forkchoice update.
 	payloadID, latestValidHash, err := ee.ec.ForkchoiceUpdated(
 		ctx,
@@ -211,13 +231,24 @@ func (ee *Engine[
 		return err
 	}
 
-	// Otherwise we will send the payload to the execution client.
-	lastValidHash, err := ee.ec.NewPayload(
-		ctx,
-		req.ExecutionPayload,
-		req.VersionedHashes,
-		req.ParentBeaconBlockRoot,
+	// Otherwise we will send the payload to the execution client, or to
+	// all of them if a verification quorum is configured.
+	var (
+		lastValidHash *common.ExecutionHash
+		err           error
 	)
+	if ee.quorum > 0 {
+		lastValidHash, err = ee.verifyNewPayloadWithQuorum(ctx, req)
+	} else {
+		lastValidHash, err = ee.ec.NewPayload(
+			ctx,
+			req.ExecutionPayload,
+			req.VersionedHashes,
+			req.ParentBeaconBlockRoot,
+			req.ExecutionRequests,
+			req.ForkVersion,
+		)
+	}
 
 	// We abstract away some of the complexity and categorize status codes
 	// to make it easier to reason about.
@@ -35,4 +35,10 @@ var (
 	ErrNilPayloadOnValidResponse = errors.New(
 		"received nil payload ID on VALID engine response",
 	)
+
+	// ErrQuorumNotReached is returned when fewer execution clients than the
+	// verification quorum deem a new payload VALID.
+	ErrQuorumNotReached = errors.New(
+		"execution clients did not reach quorum on new payload",
+	)
 )
@@ -164,6 +164,42 @@ func (em *engineMetrics) markNewPayloadUndefinedError(
 	)
 }
 
+// markNewPayloadDisagreement increments the counter for new payloads on
+// which the execution clients disagree.
+func (em *engineMetrics) markNewPayloadDisagreement(
+	payloadHash common.ExecutionHash,
+	valid, invalid uint64,
+) {
+	em.logger.Error(
+		"Execution clients disagree on new payload validity 🚨",
+		"payload_block_hash", payloadHash,
+		"valid", valid,
+		"invalid", invalid,
+	)
+
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.new_payload_disagreement",
+	)
+}
+
+// markNewPayloadQuorumNotReached increments the counter for new payloads
+// that fewer execution clients than the quorum deem VALID.
+func (em *engineMetrics) markNewPayloadQuorumNotReached(
+	payloadHash common.ExecutionHash,
+	valid, quorum uint64,
+) {
+	em.logger.Error(
+		"Execution clients did not reach quorum on new payload",
+		"payload_block_hash", payloadHash,
+		"valid", valid,
+		"quorum", quorum,
+	)
+
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.new_payload_quorum_not_reached",
+	)
+}
+
 // markNotifyForkchoiceUpdateCalled increments the counter for
 // notify forkchoice update calls.
 func (em *engineMetrics) markNotifyForkchoiceUpdateCalled(
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"context"
+	"sync"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// newPayloadVerdict is the response of a single engine client to a new
+// payload.
+type newPayloadVerdict struct {
+	lastValidHash *common.ExecutionHash
+	err           error
+}
+
+// newPayloadTally counts the responses of the engine clients to a new
+// payload by status.
+type newPayloadTally struct {
+	// valid is the number of engine clients that deemed the payload VALID.
+	valid uint64
+	// invalid is the number of engine clients that deemed the payload
+	// INVALID.
+	invalid uint64
+	// pending is the number of engine clients that responded ACCEPTED or
+	// SYNCING, which do not count towards the quorum.
+	pending uint64
+	// lastValidHash is the latest valid hash returned by the first engine
+	// client that deemed the payload VALID.
+	lastValidHash *common.ExecutionHash
+	// errs are the errors of the engine clients that failed to respond with
+	// a status.
+	errs []error
+}
+
+// tallyNewPayloadVerdicts counts the given verdicts by status.
+func tallyNewPayloadVerdicts(verdicts []newPayloadVerdict) newPayloadTally {
+	var tally newPayloadTally
+	for _, verdict := range verdicts {
+		switch {
+		case verdict.err == nil:
+			tally.valid++
+			if tally.lastValidHash == nil {
+				tally.lastValidHash = verdict.lastValidHash
+			}
+		case errors.IsAny(
+			verdict.err,
+			engineerrors.ErrInvalidPayloadStatus,
+			engineerrors.ErrInvalidBlockHashPayloadStatus,
+		):
+			tally.invalid++
+		case errors.IsAny(
+			verdict.err,
+			engineerrors.ErrAcceptedPayloadStatus,
+			engineerrors.ErrSyncingPayloadStatus,
+		):
+			tally.pending++
+		default:
+			// This includes verifiers that are not connected yet.
+			tally.errs = append(tally.errs, verdict.err)
+		}
+	}
+	return tally
+}
+
+// result returns the outcome of the verification given the quorum. The
+// payload is accepted only if at least quorum engine clients deemed it
+// VALID. Otherwise, it is rejected as invalid if any engine client deemed it
+// INVALID, and with ErrQuorumNotReached if not. ACCEPTED and SYNCING
+// responses are deliberately not surfaced, as they would let the payload be
+// optimistically accepted without the quorum.
+func (t newPayloadTally) result(
+	quorum uint64,
+) (*common.ExecutionHash, error) {
+	switch {
+	case t.valid >= quorum:
+		return t.lastValidHash, nil
+	case t.invalid > 0:
+		return nil, engineerrors.ErrInvalidPayloadStatus
+	default:
+		return nil, errors.Wrapf(
+			ErrQuorumNotReached,
+			"%d of %d VALID, %d ACCEPTED or SYNCING, %d failed",
+			t.valid, quorum, t.pending, len(t.errs),
+		)
+	}
+}
+
+// verifyNewPayloadWithQuorum sends the new payload to the engine client and
+// all the verifiers, and accepts it only if at least quorum of them deem it
+// VALID. If the quorum is not reached, the payload is rejected as invalid if
+// any execution client deemed it INVALID, and with ErrQuorumNotReached
+// otherwise.
+func (ee *Engine[
+	ExecutionPayloadT, PayloadAttributesT, _, WithdrawalsT,
+]) verifyNewPayloadWithQuorum(
+	ctx context.Context,
+	req *engineprimitives.NewPayloadRequest[
+		ExecutionPayloadT, WithdrawalsT,
+	],
+) (*common.ExecutionHash, error) {
+	var (
+		wg       sync.WaitGroup
+		verdicts = make([]newPayloadVerdict, len(ee.verifiers)+1)
+	)
+	for i, ec := range append(
+		[]*client.EngineClient[ExecutionPayloadT, PayloadAttributesT]{ee.ec},
+		ee.verifiers...,
+	) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			verdicts[i].lastValidHash, verdicts[i].err = ec.NewPayload(
+				ctx,
+				req.ExecutionPayload,
+				req.VersionedHashes,
+				req.ParentBeaconBlockRoot,
+				req.ExecutionRequests,
+				req.ForkVersion,
+			)
+		}()
+	}
+	wg.Wait()
+
+	tally := tallyNewPayloadVerdicts(verdicts)
+	for _, err := range tally.errs {
+		ee.logger.Warn(
+			"Execution client failed to verify new payload",
+			"payload_block_hash", req.ExecutionPayload.GetBlockHash(),
+			"err", err,
+		)
+	}
+
+	// Execution clients disagreeing on the validity of a payload is a sign
+	// of a bug in one of them.
+	if tally.valid > 0 && tally.invalid > 0 {
+		ee.metrics.markNewPayloadDisagreement(
+			req.ExecutionPayload.GetBlockHash(), tally.valid, tally.invalid,
+		)
+	}
+
+	lastValidHash, err := tally.result(ee.quorum)
+	if errors.Is(err, ErrQuorumNotReached) {
+		ee.metrics.markNewPayloadQuorumNotReached(
+			req.ExecutionPayload.GetBlockHash(), tally.valid, ee.quorum,
+		)
+	}
+	return lastValidHash, err
+}
+
+// notifyVerifiersForkchoiceUpdate asynchronously notifies the verifiers of
+// the forkchoice update, without having them build a payload, so that they
+// keep following the chain.
+func (ee *Engine[
+	_, PayloadAttributesT, _, _,
+]) notifyVerifiersForkchoiceUpdate(
+	ctx context.Context,
+	req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
+) {
+	var attrs PayloadAttributesT
+	for _, verifier := range ee.verifiers {
+		go func() {
+			if _, _, err := verifier.ForkchoiceUpdated(
+				context.WithoutCancel(ctx), req.State, attrs, req.ForkVersion,
+			); err != nil && !errors.IsAny(
+				err,
+				engineerrors.ErrAcceptedPayloadStatus,
+				engineerrors.ErrSyncingPayloadStatus,
+			) {
+				ee.logger.Warn(
+					"Failed to notify verifier of forkchoice update",
+					"head_eth1_hash", req.State.HeadBlockHash,
+					"err", err,
+				)
+			}
+		}()
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+var errConnection = errors.New("connection refused")
+
+func TestNewPayloadQuorum(t *testing.T) {
+	hash := &common.ExecutionHash{1}
+	valid := newPayloadVerdict{lastValidHash: hash}
+	invalid := newPayloadVerdict{err: engineerrors.ErrInvalidPayloadStatus}
+	syncing := newPayloadVerdict{err: engineerrors.ErrSyncingPayloadStatus}
+	accepted := newPayloadVerdict{err: engineerrors.ErrAcceptedPayloadStatus}
+	failed := newPayloadVerdict{err: errConnection}
+
+	tests := []struct {
+		name     string
+		verdicts []newPayloadVerdict
+		quorum   uint64
+		wantHash *common.ExecutionHash
+		wantErr  error
+	}{
+		{
+			name:     "quorum met",
+			verdicts: []newPayloadVerdict{valid, valid, failed},
+			quorum:   2,
+			wantHash: hash,
+		},
+		{
+			name:     "quorum missed",
+			verdicts: []newPayloadVerdict{valid, failed, failed},
+			quorum:   2,
+			wantErr:  ErrQuorumNotReached,
+		},
+		{
+			name:     "disagreement",
+			verdicts: []newPayloadVerdict{valid, invalid, valid},
+			quorum:   3,
+			wantErr:  engineerrors.ErrInvalidPayloadStatus,
+		},
+		{
+			name:     "disagreement with quorum met",
+			verdicts: []newPayloadVerdict{valid, invalid, valid},
+			quorum:   2,
+			wantHash: hash,
+		},
+		{
+			name:     "syncing verifier",
+			verdicts: []newPayloadVerdict{valid, syncing},
+			quorum:   2,
+			wantErr:  ErrQuorumNotReached,
+		},
+		{
+			name:     "accepted engine client",
+			verdicts: []newPayloadVerdict{accepted, valid},
+			quorum:   2,
+			wantErr:  ErrQuorumNotReached,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := tallyNewPayloadVerdicts(tt.verdicts).result(tt.quorum)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, hash)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantHash, hash)
+			}
+			// ACCEPTED and SYNCING responses must never let the payload
+			// bypass the quorum.
+			require.NotErrorIs(t, err, engineerrors.ErrAcceptedPayloadStatus)
+			require.NotErrorIs(t, err, engineerrors.ErrSyncingPayloadStatus)
+		})
+	}
+}
+
+func TestTallyNewPayloadVerdicts(t *testing.T) {
+	tally := tallyNewPayloadVerdicts([]newPayloadVerdict{
+		{lastValidHash: &common.ExecutionHash{1}},
+		{lastValidHash: &common.ExecutionHash{2}},
+		{err: engineerrors.ErrInvalidBlockHashPayloadStatus},
+		{err: engineerrors.ErrSyncingPayloadStatus},
+		{err: engineerrors.ErrAcceptedPayloadStatus},
+		{err: errConnection},
+	})
+	require.Equal(t, uint64(2), tally.valid)
+	require.Equal(t, uint64(1), tally.invalid)
+	require.Equal(t, uint64(2), tally.pending)
+	require.Equal(t, &common.ExecutionHash{1}, tally.lastValidHash)
+	require.Equal(t, []error{errConnection}, tally.errs)
+}
@@ -21,6 +21,7 @@
 package gethprimitives
 
 import (
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -45,6 +46,7 @@ type (
 	LogsBloom      = coretypes.Bloom
 	Header         = coretypes.Header
 	Receipt        = coretypes.Receipt
+	SyncProgress   = ethereum.SyncProgress
 	Transaction    = coretypes.Transaction
 	Transactions   = coretypes.Transactions
 	Withdrawals    = coretypes.Withdrawals
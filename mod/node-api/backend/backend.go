@@ -57,6 +57,7 @@ type Backend[
 	],
 	ValidatorT Validator[WithdrawalCredentialsT],
 	ValidatorsT ~[]ValidatorT,
+	VoluntaryExitT any,
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ] struct {
@@ -64,7 +65,20 @@ type Backend[
 	cs   common.ChainSpec
 	node NodeT
 
-	sp StateProcessor[BeaconStateT]
+	sp StateProcessor[BeaconStateT, VoluntaryExitT]
+
+	// exitPool holds the voluntary exits submitted through the node-api
+	// until they are included in a block.
+	exitPool VoluntaryExitPool[VoluntaryExitT]
+
+	// consensusClient is used to query the p2p and sync status of the
+	// consensus engine.
+	consensusClient ConsensusClient
+	// executionClient is used to query the sync status of the execution
+	// client.
+	executionClient ExecutionClient
+	// version is the version of the running node.
+	version string
 }
 
 // New creates and returns a new Backend instance.
@@ -95,49 +109,58 @@ func New[
 	],
 	ValidatorT Validator[WithdrawalCredentialsT],
 	ValidatorsT ~[]ValidatorT,
+	VoluntaryExitT any,
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ](
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
-	sp StateProcessor[BeaconStateT],
+	sp StateProcessor[BeaconStateT, VoluntaryExitT],
+	exitPool VoluntaryExitPool[VoluntaryExitT],
+	consensusClient ConsensusClient,
+	executionClient ExecutionClient,
+	version string,
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 	ContextT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-	NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT, WithdrawalT,
-	WithdrawalCredentialsT,
+	NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT,
+	VoluntaryExitT, WithdrawalT, WithdrawalCredentialsT,
 ] {
 	return &Backend[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 		ContextT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-		NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT, WithdrawalT,
-		WithdrawalCredentialsT,
+		NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT,
+		VoluntaryExitT, WithdrawalT, WithdrawalCredentialsT,
 	]{
-		sb: storageBackend,
-		cs: cs,
-		sp: sp,
+		sb:              storageBackend,
+		cs:              cs,
+		sp:              sp,
+		exitPool:        exitPool,
+		consensusClient: consensusClient,
+		executionClient: executionClient,
+		version:         version,
 	}
 }
 
 // AttachNode sets the node on the backend for querying historical heights.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _, _,
 ]) AttachNode(node NodeT) {
 	b.node = node
 }
 
 // ChainSpec returns the chain spec from the backend.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _, _,
 ]) ChainSpec() common.ChainSpec {
 	return b.cs
 }
 
 // GetSlotByRoot retrieves the slot by a given root from the block store.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetSlotByRoot(root common.Root) (math.Slot, error) {
 	return b.sb.BlockStore().GetSlotByRoot(root)
 }
@@ -145,7 +168,7 @@ func (b *Backend[
 // GetSlotByExecutionNumber retrieves the slot by a given execution number from
 // the block store.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error) {
 	return b.sb.BlockStore().GetSlotByExecutionNumber(executionNumber)
 }
@@ -153,7 +176,7 @@ func (b *Backend[
 // stateFromSlot returns the state at the given slot, after also processing the
 // next slot to ensure the returned beacon state is up to date.
 func (b *Backend[
-	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) stateFromSlot(slot math.Slot) (BeaconStateT, math.Slot, error) {
 	var (
 		st  BeaconStateT
@@ -178,7 +201,7 @@ func (b *Backend[
 // resolving an input slot of 0 to the latest slot. It does not process the
 // next slot on the beacon state.
 func (b *Backend[
-	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) stateFromSlotRaw(slot math.Slot) (BeaconStateT, math.Slot, error) {
 	var st BeaconStateT
 	//#nosec:G701 // not an issue in practice.
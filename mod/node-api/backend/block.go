@@ -21,15 +21,40 @@
 package backend
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	types "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// BlockAtSlot returns the beacon block at the given slot from the block store,
+// resolving a slot of 0 to the latest slot.
+func (b Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlockAtSlot(slot math.Slot) (BeaconBlockT, error) {
+	var (
+		blk BeaconBlockT
+		err error
+	)
+	if slot == 0 {
+		if _, slot, err = b.stateFromSlotRaw(slot); err != nil {
+			return blk, err
+		}
+	}
+
+	// The block store only holds blocks within its retention window.
+	blk, err = b.sb.BlockStore().Get(slot)
+	if err != nil {
+		return blk, errors.Join(apitypes.ErrNotFound, err)
+	}
+	return blk, nil
+}
+
 // BlockHeader returns the block header at the given slot.
 func (b Backend[
 	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
-	_,
+	_, _,
 ]) BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error) {
 	var blockHeader BeaconBlockHeaderT
 
@@ -44,7 +69,7 @@ func (b Backend[
 
 // GetBlockRoot returns the root of the block at the given stateID.
 func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
 	st, slot, err := b.stateFromSlot(slot)
 	if err != nil {
@@ -58,7 +83,7 @@ func (b Backend[
 
 // TODO: Implement this.
 func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) BlockRewardsAtSlot(math.Slot) (*types.BlockRewardsData, error) {
 	return &types.BlockRewardsData{
 		ProposerIndex:     1,
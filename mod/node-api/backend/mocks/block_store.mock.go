@@ -22,6 +22,62 @@ func (_m *BlockStore[BeaconBlockT]) EXPECT() *BlockStore_Expecter[BeaconBlockT]
 	return &BlockStore_Expecter[BeaconBlockT]{mock: &_m.Mock}
 }
 
+// Get provides a mock function with given fields: slot
+func (_m *BlockStore[BeaconBlockT]) Get(slot math.U64) (BeaconBlockT, error) {
+	ret := _m.Called(slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 BeaconBlockT
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (BeaconBlockT, error)); ok {
+		return rf(slot)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) BeaconBlockT); ok {
+		r0 = rf(slot)
+	} else {
+		r0 = ret.Get(0).(BeaconBlockT)
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type BlockStore_Get_Call[BeaconBlockT interface{}] struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - slot math.U64
+func (_e *BlockStore_Expecter[BeaconBlockT]) Get(slot interface{}) *BlockStore_Get_Call[BeaconBlockT] {
+	return &BlockStore_Get_Call[BeaconBlockT]{Call: _e.mock.On("Get", slot)}
+}
+
+func (_c *BlockStore_Get_Call[BeaconBlockT]) Run(run func(slot math.U64)) *BlockStore_Get_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_Get_Call[BeaconBlockT]) Return(_a0 BeaconBlockT, _a1 error) *BlockStore_Get_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_Get_Call[BeaconBlockT]) RunAndReturn(run func(math.U64) (BeaconBlockT, error)) *BlockStore_Get_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSlotByExecutionNumber provides a mock function with given fields: executionNumber
 func (_m *BlockStore[BeaconBlockT]) GetSlotByExecutionNumber(executionNumber math.U64) (math.U64, error) {
 	ret := _m.Called(executionNumber)
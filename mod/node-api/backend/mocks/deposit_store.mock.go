@@ -122,6 +122,63 @@ func (_c *DepositStore_GetDepositsByIndex_Call[DepositT]) RunAndReturn(run func(
 	return _c
 }
 
+// GetTreeSnapshot provides a mock function with given fields:
+func (_m *DepositStore[DepositT]) GetTreeSnapshot() ([]byte, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTreeSnapshot")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]byte, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DepositStore_GetTreeSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTreeSnapshot'
+type DepositStore_GetTreeSnapshot_Call[DepositT interface{}] struct {
+	*mock.Call
+}
+
+// GetTreeSnapshot is a helper method to define mock.On call
+func (_e *DepositStore_Expecter[DepositT]) GetTreeSnapshot() *DepositStore_GetTreeSnapshot_Call[DepositT] {
+	return &DepositStore_GetTreeSnapshot_Call[DepositT]{Call: _e.mock.On("GetTreeSnapshot")}
+}
+
+func (_c *DepositStore_GetTreeSnapshot_Call[DepositT]) Run(run func()) *DepositStore_GetTreeSnapshot_Call[DepositT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DepositStore_GetTreeSnapshot_Call[DepositT]) Return(_a0 []byte, _a1 error) *DepositStore_GetTreeSnapshot_Call[DepositT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DepositStore_GetTreeSnapshot_Call[DepositT]) RunAndReturn(run func() ([]byte, error)) *DepositStore_GetTreeSnapshot_Call[DepositT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Prune provides a mock function with given fields: start, end
 func (_m *DepositStore[DepositT]) Prune(start uint64, end uint64) error {
 	ret := _m.Called(start, end)
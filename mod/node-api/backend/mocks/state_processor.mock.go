@@ -10,20 +10,20 @@ import (
 )
 
 // StateProcessor is an autogenerated mock type for the StateProcessor type
-type StateProcessor[BeaconStateT interface{}] struct {
+type StateProcessor[BeaconStateT interface{}, VoluntaryExitT interface{}] struct {
 	mock.Mock
 }
 
-type StateProcessor_Expecter[BeaconStateT interface{}] struct {
+type StateProcessor_Expecter[BeaconStateT interface{}, VoluntaryExitT interface{}] struct {
 	mock *mock.Mock
 }
 
-func (_m *StateProcessor[BeaconStateT]) EXPECT() *StateProcessor_Expecter[BeaconStateT] {
-	return &StateProcessor_Expecter[BeaconStateT]{mock: &_m.Mock}
+func (_m *StateProcessor[BeaconStateT, VoluntaryExitT]) EXPECT() *StateProcessor_Expecter[BeaconStateT, VoluntaryExitT] {
+	return &StateProcessor_Expecter[BeaconStateT, VoluntaryExitT]{mock: &_m.Mock}
 }
 
 // ProcessSlots provides a mock function with given fields: _a0, _a1
-func (_m *StateProcessor[BeaconStateT]) ProcessSlots(_a0 BeaconStateT, _a1 math.U64) (transition.ValidatorUpdates, error) {
+func (_m *StateProcessor[BeaconStateT, VoluntaryExitT]) ProcessSlots(_a0 BeaconStateT, _a1 math.U64) (transition.ValidatorUpdates, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
@@ -53,41 +53,88 @@ func (_m *StateProcessor[BeaconStateT]) ProcessSlots(_a0 BeaconStateT, _a1 math.
 }
 
 // StateProcessor_ProcessSlots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessSlots'
-type StateProcessor_ProcessSlots_Call[BeaconStateT interface{}] struct {
+type StateProcessor_ProcessSlots_Call[BeaconStateT interface{}, VoluntaryExitT interface{}] struct {
 	*mock.Call
 }
 
 // ProcessSlots is a helper method to define mock.On call
 //   - _a0 BeaconStateT
 //   - _a1 math.U64
-func (_e *StateProcessor_Expecter[BeaconStateT]) ProcessSlots(_a0 interface{}, _a1 interface{}) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
-	return &StateProcessor_ProcessSlots_Call[BeaconStateT]{Call: _e.mock.On("ProcessSlots", _a0, _a1)}
+func (_e *StateProcessor_Expecter[BeaconStateT, VoluntaryExitT]) ProcessSlots(_a0 interface{}, _a1 interface{}) *StateProcessor_ProcessSlots_Call[BeaconStateT, VoluntaryExitT] {
+	return &StateProcessor_ProcessSlots_Call[BeaconStateT, VoluntaryExitT]{Call: _e.mock.On("ProcessSlots", _a0, _a1)}
 }
 
-func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT]) Run(run func(_a0 BeaconStateT, _a1 math.U64)) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
+func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT, VoluntaryExitT]) Run(run func(_a0 BeaconStateT, _a1 math.U64)) *StateProcessor_ProcessSlots_Call[BeaconStateT, VoluntaryExitT] {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(BeaconStateT), args[1].(math.U64))
 	})
 	return _c
 }
 
-func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT]) Return(_a0 transition.ValidatorUpdates, _a1 error) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
+func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT, VoluntaryExitT]) Return(_a0 transition.ValidatorUpdates, _a1 error) *StateProcessor_ProcessSlots_Call[BeaconStateT, VoluntaryExitT] {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT]) RunAndReturn(run func(BeaconStateT, math.U64) (transition.ValidatorUpdates, error)) *StateProcessor_ProcessSlots_Call[BeaconStateT] {
+func (_c *StateProcessor_ProcessSlots_Call[BeaconStateT, VoluntaryExitT]) RunAndReturn(run func(BeaconStateT, math.U64) (transition.ValidatorUpdates, error)) *StateProcessor_ProcessSlots_Call[BeaconStateT, VoluntaryExitT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateVoluntaryExit provides a mock function with given fields: _a0, _a1
+func (_m *StateProcessor[BeaconStateT, VoluntaryExitT]) ValidateVoluntaryExit(_a0 BeaconStateT, _a1 VoluntaryExitT) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateVoluntaryExit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(BeaconStateT, VoluntaryExitT) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateProcessor_ValidateVoluntaryExit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateVoluntaryExit'
+type StateProcessor_ValidateVoluntaryExit_Call[BeaconStateT interface{}, VoluntaryExitT interface{}] struct {
+	*mock.Call
+}
+
+// ValidateVoluntaryExit is a helper method to define mock.On call
+//   - _a0 BeaconStateT
+//   - _a1 VoluntaryExitT
+func (_e *StateProcessor_Expecter[BeaconStateT, VoluntaryExitT]) ValidateVoluntaryExit(_a0 interface{}, _a1 interface{}) *StateProcessor_ValidateVoluntaryExit_Call[BeaconStateT, VoluntaryExitT] {
+	return &StateProcessor_ValidateVoluntaryExit_Call[BeaconStateT, VoluntaryExitT]{Call: _e.mock.On("ValidateVoluntaryExit", _a0, _a1)}
+}
+
+func (_c *StateProcessor_ValidateVoluntaryExit_Call[BeaconStateT, VoluntaryExitT]) Run(run func(_a0 BeaconStateT, _a1 VoluntaryExitT)) *StateProcessor_ValidateVoluntaryExit_Call[BeaconStateT, VoluntaryExitT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(BeaconStateT), args[1].(VoluntaryExitT))
+	})
+	return _c
+}
+
+func (_c *StateProcessor_ValidateVoluntaryExit_Call[BeaconStateT, VoluntaryExitT]) Return(_a0 error) *StateProcessor_ValidateVoluntaryExit_Call[BeaconStateT, VoluntaryExitT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateProcessor_ValidateVoluntaryExit_Call[BeaconStateT, VoluntaryExitT]) RunAndReturn(run func(BeaconStateT, VoluntaryExitT) error) *StateProcessor_ValidateVoluntaryExit_Call[BeaconStateT, VoluntaryExitT] {
 	_c.Call.Return(run)
 	return _c
 }
 
 // NewStateProcessor creates a new instance of StateProcessor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewStateProcessor[BeaconStateT interface{}](t interface {
+func NewStateProcessor[BeaconStateT interface{}, VoluntaryExitT interface{}](t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *StateProcessor[BeaconStateT] {
-	mock := &StateProcessor[BeaconStateT]{}
+}) *StateProcessor[BeaconStateT, VoluntaryExitT] {
+	mock := &StateProcessor[BeaconStateT, VoluntaryExitT]{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
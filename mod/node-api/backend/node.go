@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+)
+
+// NodeIdentity returns the p2p identity of the node.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) NodeIdentity(ctx context.Context) (*nodetypes.IdentityData, error) {
+	return b.consensusClient.Identity(ctx)
+}
+
+// NodePeers returns the peers the node is connected to.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) NodePeers(ctx context.Context) ([]*nodetypes.PeerData, error) {
+	return b.consensusClient.Peers(ctx)
+}
+
+// NodeVersion returns the version of the node.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) NodeVersion() string {
+	return b.version
+}
+
+// NodeSyncing returns the sync status of the node by comparing the head slot
+// of the latest beacon state against the latest height of the consensus
+// engine.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) NodeSyncing(ctx context.Context) (*nodetypes.SyncingData, error) {
+	_, headSlot, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return nil, err
+	}
+	latestHeight, catchingUp, err := b.consensusClient.SyncInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var syncDistance uint64
+	if latestHeight > headSlot.Unwrap() {
+		syncDistance = latestHeight - headSlot.Unwrap()
+	}
+
+	// While the execution client is syncing it only accepts payloads without
+	// validating them, so the head of the node is optimistic.
+	progress, err := b.executionClient.SyncProgress(ctx)
+	return &nodetypes.SyncingData{
+		HeadSlot:     headSlot.Unwrap(),
+		SyncDistance: syncDistance,
+		IsSyncing:    catchingUp || syncDistance > 0,
+		IsOptimistic: err == nil && progress != nil,
+		ELOffline:    err != nil,
+	}, nil
+}
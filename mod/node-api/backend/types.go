@@ -23,6 +23,8 @@ package backend
 import (
 	"context"
 
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -34,6 +36,9 @@ import (
 // sidecars for specific blocks, as well as verifying sidecars that have already
 // been stored.
 type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
+	// GetBlobSidecars returns the sidecars stored for the given slot,
+	// restricted to the given indices if any are provided.
+	GetBlobSidecars(slot math.Slot, indices []uint64) (BlobSidecarsT, error)
 	// IsDataAvailable ensures that all blobs referenced in the block are
 	// securely stored before it returns without an error.
 	IsDataAvailable(
@@ -79,6 +84,8 @@ type BeaconState[
 
 // BlockStore is the interface for block storage.
 type BlockStore[BeaconBlockT any] interface {
+	// Get retrieves the block at the given slot from the store.
+	Get(slot math.Slot) (BeaconBlockT, error)
 	// GetSlotByRoot retrieves the slot by a given root from the store.
 	GetSlotByRoot(root common.Root) (math.Slot, error)
 	// GetSlotByExecutionNumber retrieves the slot by a given execution number
@@ -86,6 +93,18 @@ type BlockStore[BeaconBlockT any] interface {
 	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
 }
 
+// ConsensusClient is the interface for querying the p2p and sync status of
+// the consensus engine.
+type ConsensusClient interface {
+	// Identity returns the p2p identity of the node.
+	Identity(ctx context.Context) (*nodetypes.IdentityData, error)
+	// Peers returns the peers the node is connected to.
+	Peers(ctx context.Context) ([]*nodetypes.PeerData, error)
+	// SyncInfo returns the latest height of the consensus engine and whether
+	// it is still catching up with the network.
+	SyncInfo(ctx context.Context) (uint64, bool, error)
+}
+
 // DepositStore defines the interface for deposit storage.
 type DepositStore[DepositT any] interface {
 	// GetDepositsByIndex returns `numView` expected deposits.
@@ -94,6 +113,17 @@ type DepositStore[DepositT any] interface {
 	Prune(start, end uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// GetTreeSnapshot returns the persisted snapshot of the deposit tree, or
+	// nil if none has been persisted.
+	GetTreeSnapshot() ([]byte, error)
+}
+
+// ExecutionClient is the interface for querying the sync status of the
+// execution client.
+type ExecutionClient interface {
+	// SyncProgress returns the sync progress of the execution client, or nil
+	// if it is not syncing.
+	SyncProgress(ctx context.Context) (*gethprimitives.SyncProgress, error)
 }
 
 // Node is the interface for a node.
@@ -103,8 +133,11 @@ type Node[ContextT any] interface {
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
 }
 
-type StateProcessor[BeaconStateT any] interface {
+type StateProcessor[BeaconStateT, VoluntaryExitT any] interface {
 	ProcessSlots(BeaconStateT, math.Slot) (transition.ValidatorUpdates, error)
+	// ValidateVoluntaryExit checks that the voluntary exit can be included
+	// in a block built on top of the given state.
+	ValidateVoluntaryExit(BeaconStateT, VoluntaryExitT) error
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -132,6 +165,13 @@ type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
 	IsPartiallyWithdrawable(amount1 math.Gwei, amount2 math.Gwei) bool
 }
 
+// VoluntaryExitPool is the interface for the pool of voluntary exits waiting
+// to be included in a block.
+type VoluntaryExitPool[VoluntaryExitT any] interface {
+	// Add adds the voluntary exit to the pool.
+	Add(VoluntaryExitT)
+}
+
 // Withdrawal represents an interface for a withdrawal.
 type Withdrawal[T any] interface {
 	New(
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"io"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/labstack/echo/v4"
+)
+
+// Binder binds requests like the default echo binder, but additionally
+// decodes SSZ encoded bodies into requests that support it.
+type Binder struct {
+	echo.DefaultBinder
+}
+
+// Bind binds the path parameters and the body of the request to i. SSZ
+// encoded bodies are rejected with ErrUnsupportedMediaType if i can not be
+// decoded from SSZ.
+func (b *Binder) Bind(i any, c Context) error {
+	req := c.Request()
+	if !strings.HasPrefix(
+		req.Header.Get(echo.HeaderContentType), types.SSZContentType,
+	) {
+		return b.DefaultBinder.Bind(i, c)
+	}
+
+	ssz, ok := i.(constraints.SSZUnmarshaler)
+	if !ok {
+		return types.ErrUnsupportedMediaType
+	}
+	if err := b.BindPathParams(c, i); err != nil {
+		return err
+	}
+	bz, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	return ssz.UnmarshalSSZ(bz)
+}
@@ -46,6 +46,7 @@ func NewDefaultEngine() *Engine {
 	engine.Use(middleware.CORSWithConfig(
 		middleware.DefaultCORSConfig,
 	))
+	engine.Binder = &Binder{}
 	engine.Validator = &CustomValidator{
 		Validator: ConstructValidator(),
 	}
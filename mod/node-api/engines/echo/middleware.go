@@ -21,7 +21,10 @@
 package echo
 
 import (
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
@@ -42,11 +45,82 @@ func responseMiddleware(
 ) echo.HandlerFunc {
 	return func(c Context) error {
 		data, err := handler.Handler(c)
+		if stream, ok := data.(types.StreamResponse); ok && err == nil {
+			return streamResponse(c, stream)
+		}
+		if status, ok := data.(types.StatusResponse); ok && err == nil {
+			return c.NoContent(status.Code)
+		}
+		if versioned, ok := data.(types.VersionedResponse); ok && err == nil {
+			c.Response().Header().Set(
+				types.ConsensusVersionHeader, versioned.ConsensusVersion(),
+			)
+		}
+		if err == nil {
+			preferSSZ, acceptJSON := negotiateSSZ(c.Request())
+			if preferSSZ {
+				bz, sszErr := types.MarshalSSZ(data)
+				switch {
+				case sszErr == nil:
+					return c.Blob(http.StatusOK, types.SSZContentType, bz)
+				case !errors.Is(sszErr, types.ErrSSZNotSupported) ||
+					!acceptJSON:
+					// Only fall back to JSON if the client accepts it.
+					err = sszErr
+				}
+			}
+		}
 		code, response := responseFromError(data, err)
 		return c.JSON(code, response)
 	}
 }
 
+// negotiateSSZ reports whether the client prefers an SSZ encoded response
+// over JSON and whether it accepts JSON at all, according to the quality
+// values in its Accept header. Ties are resolved in favour of SSZ, since
+// clients list the media type they prefer first.
+func negotiateSSZ(req *http.Request) (bool, bool) {
+	accept := req.Header.Get(echo.HeaderAccept)
+	if accept == "" {
+		return false, true
+	}
+
+	var qSSZ, qJSON float64
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case types.SSZContentType:
+			qSSZ = max(qSSZ, q)
+		case echo.MIMEApplicationJSON, "application/*", "*/*":
+			qJSON = max(qJSON, q)
+		}
+	}
+	return qSSZ > 0 && qSSZ >= qJSON, qJSON > 0
+}
+
+// streamResponse writes a streaming response to the client until either the
+// client disconnects or the stream ends.
+func streamResponse(c Context, stream types.StreamResponse) error {
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, stream.ContentType())
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	header.Set(echo.HeaderConnection, "keep-alive")
+	c.Response().WriteHeader(http.StatusOK)
+	c.Response().Flush()
+	return stream.Stream(
+		c.Request().Context(), c.Response(), c.Response().Flush,
+	)
+}
+
 // responseFromErr converts an error to an HTTP status code and response. If
 // the error is nil, the response is returned as is.
 func responseFromError(data any, err error) (int, any) {
@@ -63,6 +137,16 @@ func responseFromError(data any, err error) (int, any) {
 			Code:    http.StatusBadRequest,
 			Message: err.Error(),
 		}
+	case errors.Is(err, types.ErrSSZNotSupported):
+		return http.StatusNotAcceptable, ErrorResponse{
+			Code:    http.StatusNotAcceptable,
+			Message: err.Error(),
+		}
+	case errors.Is(err, types.ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType, ErrorResponse{
+			Code:    http.StatusUnsupportedMediaType,
+			Message: err.Error(),
+		}
 	case errors.Is(err, types.ErrNotImplemented):
 		return http.StatusNotImplemented, ErrorResponse{
 			Code:    http.StatusNotImplemented,
@@ -92,6 +92,11 @@ func ValidateBlockID(fl validator.FieldLevel) bool {
 		"genesis":   true,
 		"finalized": true,
 	}
+
+	if utils.IsExecutionNumberPrefix(fl.Field().String()) {
+		return true
+	}
+
 	return validateStateBlockIDs(fl, allowedValues)
 }
 
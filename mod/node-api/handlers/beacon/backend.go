@@ -27,30 +27,50 @@ import (
 )
 
 // Backend is the interface for backend of the beacon API.
-type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
+type Backend[
+	BlockT, BlockHeaderT, BlobSidecarsT, ForkT, ValidatorT,
+	VoluntaryExitT any,
+] interface {
 	GenesisBackend
-	BlockBackend[BlockHeaderT]
+	BlobBackend[BlobSidecarsT]
+	BlockBackend[BlockT, BlockHeaderT]
 	RandaoBackend
 	StateBackend[ForkT]
 	ValidatorBackend[ValidatorT]
 	HistoricalBackend[ForkT]
+	PoolBackend[VoluntaryExitT]
+	DepositBackend
 	GetSlotByRoot(root common.Root) (math.Slot, error)
+	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
 }
 
 type GenesisBackend interface {
 	GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
 }
 
+type BlobBackend[BlobSidecarsT any] interface {
+	BlobSidecarsAtSlot(slot math.Slot, indices []uint64) (BlobSidecarsT, error)
+}
+
 type HistoricalBackend[ForkT any] interface {
 	StateRootAtSlot(slot math.Slot) (common.Root, error)
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
 }
 
+type PoolBackend[VoluntaryExitT any] interface {
+	SubmitVoluntaryExit(exit VoluntaryExitT) error
+}
+
+type DepositBackend interface {
+	DepositSnapshot() (*types.DepositSnapshotData, error)
+}
+
 type RandaoBackend interface {
 	RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 }
 
-type BlockBackend[BeaconBlockHeaderT any] interface {
+type BlockBackend[BeaconBlockT, BeaconBlockHeaderT any] interface {
+	BlockAtSlot(slot math.Slot) (BeaconBlockT, error)
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// GetBlobSidecars returns the blob sidecars of the block for the given block
+// id, optionally filtered by the indices of the blobs.
+func (h *Handler[
+	_, BeaconBlockHeaderT, BlobSidecarT, _, ContextT, _, _, _,
+]) GetBlobSidecars(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlobSidecarsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]uint64, len(req.Indices))
+	for i, index := range req.Indices {
+		if indices[i], err = strconv.ParseUint(index, 10, 64); err != nil {
+			return nil, errors.Wrapf(
+				types.ErrInvalidRequest, "invalid index %q: %v", index, err,
+			)
+		}
+	}
+	sidecars, err := h.backend.BlobSidecarsAtSlot(slot, indices)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(
+		[]*beacontypes.BlobSidecarData[BeaconBlockHeaderT],
+		0, len(sidecars.GetSidecars()),
+	)
+	for _, sidecar := range sidecars.GetSidecars() {
+		data = append(data, &beacontypes.BlobSidecarData[BeaconBlockHeaderT]{
+			Index:         sidecar.GetIndex(),
+			Blob:          sidecar.GetBlob(),
+			KzgCommitment: sidecar.GetKzgCommitment(),
+			KzgProof:      sidecar.GetKzgProof(),
+			SignedBlockHeader: &beacontypes.BlockHeader[BeaconBlockHeaderT]{
+				Message:   sidecar.GetBeaconBlockHeader(),
+				Signature: crypto.BLSSignature{}, // TODO: implement
+			},
+			KzgCommitmentInclusionProof: sidecar.GetInclusionProof(),
+		})
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,
+		Data: types.SSZData{
+			JSON: data,
+			SSZ:  types.SSZList[BlobSidecarT](sidecars.GetSidecars()),
+		},
+	}, nil
+}
@@ -22,10 +22,78 @@ package beacon
 
 import (
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
+// GetBlock returns the beacon block for the given block id. Blocks are only
+// stored once finalized, which is immediate in CometBFT.
+func (h *Handler[BeaconBlockT, _, _, _, ContextT, _, _, _]) GetBlock(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.backend.BlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.BlockResponse{
+		Version: version.Name(blk.Version()),
+		ValidatorResponse: beacontypes.ValidatorResponse{
+			ExecutionOptimistic: false, // stubbed
+			Finalized:           true,
+			// Blocks are not signed by their proposer, the proposal is
+			// signed by CometBFT instead, so the signature is always empty.
+			Data: &beacontypes.SignedBeaconBlock[BeaconBlockT]{
+				Message:   blk,
+				Signature: crypto.BLSSignature{},
+			},
+		},
+	}, nil
+}
+
+// GetBlockRoot returns the root of the beacon block for the given block id.
+func (h *Handler[_, _, _, _, ContextT, _, _, _]) GetBlockRoot(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlockRootRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	root, err := h.backend.BlockRootAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if root == (common.Root{}) {
+		return nil, types.ErrNotFound
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,
+		Data:                beacontypes.RootData{Root: root},
+	}, nil
+}
+
+func (h *Handler[_, _, _, _, ContextT, _, _, _]) GetBlockRewards(
+	c ContextT,
+) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockRewardsRequest](
 		c, h.Logger(),
 	)
@@ -28,25 +28,45 @@ import (
 
 // Handler is the handler for the beacon API.
 type Handler[
+	BeaconBlockT types.BeaconBlock,
 	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
 	ContextT context.Context,
 	ForkT any,
 	ValidatorT any,
+	VoluntaryExitT types.VoluntaryExit[VoluntaryExitT],
 ] struct {
 	*handlers.BaseHandler[ContextT]
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT]
+	backend Backend[
+		BeaconBlockT, BeaconBlockHeaderT, BlobSidecarsT, ForkT, ValidatorT,
+		VoluntaryExitT,
+	]
 }
 
 // NewHandler creates a new handler for the beacon API.
 func NewHandler[
+	BeaconBlockT types.BeaconBlock,
 	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
 	ContextT context.Context,
 	ForkT any,
 	ValidatorT any,
+	VoluntaryExitT types.VoluntaryExit[VoluntaryExitT],
 ](
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT],
-) *Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT] {
-	h := &Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT]{
+	backend Backend[
+		BeaconBlockT, BeaconBlockHeaderT, BlobSidecarsT, ForkT, ValidatorT,
+		VoluntaryExitT,
+	],
+) *Handler[
+	BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT, ContextT,
+	ForkT, ValidatorT, VoluntaryExitT,
+] {
+	h := &Handler[
+		BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT, ContextT,
+		ForkT, ValidatorT, VoluntaryExitT,
+	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
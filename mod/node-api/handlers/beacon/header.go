@@ -23,11 +23,11 @@ package beacon
 import (
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
 func (h *Handler[
-	BeaconBlockHeaderT, ContextT, _, _,
+	_, BeaconBlockHeaderT, _, _, ContextT, _, _, _,
 ]) GetBlockHeaders(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockHeadersRequest](
 		c, h.Logger(),
@@ -51,14 +51,14 @@ func (h *Handler[
 			Canonical: true,
 			Header: &beacontypes.BlockHeader[BeaconBlockHeaderT]{
 				Message:   header,
-				Signature: bytes.B48{}, // TODO: implement
+				Signature: crypto.BLSSignature{}, // TODO: implement
 			},
 		},
 	}, nil
 }
 
 func (h *Handler[
-	BeaconBlockHeaderT, ContextT, _, _,
+	_, BeaconBlockHeaderT, _, _, ContextT, _, _, _,
 ]) GetBlockHeaderByID(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockHeaderRequest](
 		c, h.Logger(),
@@ -82,7 +82,7 @@ func (h *Handler[
 			Canonical: true,
 			Header: &beacontypes.BlockHeader[BeaconBlockHeaderT]{
 				Message:   header,
-				Signature: bytes.B48{}, // TODO: implement
+				Signature: crypto.BLSSignature{}, // TODO: implement
 			},
 		},
 	}, nil
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+)
+
+// SubmitVoluntaryExit submits a signed voluntary exit to the pool. The exit
+// is validated against the head state before it is accepted. Exits are not
+// propagated to other nodes, they are only included in blocks proposed by
+// this node.
+func (h *Handler[
+	_, _, _, _, ContextT, _, _, VoluntaryExitT,
+]) SubmitVoluntaryExit(c ContextT) (any, error) {
+	var exit VoluntaryExitT
+	exit = exit.Empty()
+	if err := c.Bind(exit); err != nil {
+		if errors.Is(err, types.ErrUnsupportedMediaType) {
+			return nil, err
+		}
+		return nil, types.ErrInvalidRequest
+	}
+	if err := h.backend.SubmitVoluntaryExit(exit); err != nil {
+		return nil, err
+	}
+	return types.StatusResponse{Code: http.StatusOK}, nil
+}
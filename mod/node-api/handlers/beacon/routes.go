@@ -28,7 +28,7 @@ import (
 )
 
 //nolint:funlen // routes are long
-func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
+func (h *Handler[_, _, _, _, ContextT, _, _, _]) RegisterRoutes(
 	logger log.Logger[any],
 ) {
 	h.SetLogger(logger)
@@ -125,13 +125,13 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		},
 		{
 			Method:  http.MethodGet,
-			Path:    "eth/v2/beacon/blocks/:block_id",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v2/beacon/blocks/:block_id",
+			Handler: h.GetBlock,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blocks/:block_id/root",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlockRoot,
 		},
 		{
 			Method:  http.MethodGet,
@@ -141,7 +141,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blob_sidecars/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlobSidecars,
 		},
 		{
 			Method:  http.MethodPost,
@@ -151,7 +151,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/deposit_snapshot",
-			Handler: h.NotImplemented,
+			Handler: h.GetDepositSnapshot,
 		},
 		{
 			Method:  http.MethodPost,
@@ -226,7 +226,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.SubmitVoluntaryExit,
 		},
 		{
 			Method:  http.MethodGet,
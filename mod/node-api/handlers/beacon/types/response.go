@@ -21,30 +21,92 @@
 package types
 
 import (
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
+// signedBeaconBlockFixedSize is the size of the fixed part of the SSZ
+// encoding of a signed beacon block: the offset of the message followed by
+// the signature.
+const signedBeaconBlockFixedSize = 4 + 96
+
 type ValidatorResponse struct {
 	ExecutionOptimistic bool `json:"execution_optimistic"`
 	Finalized           bool `json:"finalized"`
 	Data                any  `json:"data"`
 }
 
+// MarshalSSZ returns the SSZ encoding of the data of the response.
+func (r ValidatorResponse) MarshalSSZ() ([]byte, error) {
+	return types.MarshalSSZ(r.Data)
+}
+
 type BlockResponse struct {
 	Version string `json:"version"`
 	ValidatorResponse
 }
 
+// ConsensusVersion returns the fork the data of the response belongs to.
+func (r BlockResponse) ConsensusVersion() string {
+	return r.Version
+}
+
+// SignedBeaconBlock is a beacon block along with the signature of its
+// proposer.
+type SignedBeaconBlock[BeaconBlockT BeaconBlock] struct {
+	Message   BeaconBlockT        `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// MarshalSSZ returns the SSZ encoding of the signed beacon block.
+func (b *SignedBeaconBlock[_]) MarshalSSZ() ([]byte, error) {
+	msg, err := b.Message.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	bz := make([]byte, 0, signedBeaconBlockFixedSize+len(msg))
+	bz = binary.LittleEndian.AppendUint32(bz, signedBeaconBlockFixedSize)
+	bz = append(bz, b.Signature[:]...)
+	return append(bz, msg...), nil
+}
+
 type BlockHeaderResponse[BlockHeaderT any] struct {
 	Root      common.Root                `json:"root"`
 	Canonical bool                       `json:"canonical"`
 	Header    *BlockHeader[BlockHeaderT] `json:"header"`
 }
 
+// MarshalSSZ returns the SSZ encoding of the header of the response.
+func (r *BlockHeaderResponse[_]) MarshalSSZ() ([]byte, error) {
+	return r.Header.MarshalSSZ()
+}
+
 type BlockHeader[BlockHeaderT any] struct {
-	Message   BlockHeaderT `json:"message"`
-	Signature bytes.B48    `json:"signature"`
+	Message   BlockHeaderT        `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// MarshalSSZ returns the SSZ encoding of the header followed by its
+// signature.
+func (h *BlockHeader[_]) MarshalSSZ() ([]byte, error) {
+	msg, err := types.MarshalSSZ(h.Message)
+	if err != nil {
+		return nil, err
+	}
+	return append(msg, h.Signature[:]...), nil
+}
+
+type BlobSidecarData[BlockHeaderT any] struct {
+	Index                       uint64                     `json:"index,string"`
+	Blob                        eip4844.Blob               `json:"blob"`
+	KzgCommitment               eip4844.KZGCommitment      `json:"kzg_commitment"`
+	KzgProof                    eip4844.KZGProof           `json:"kzg_proof"`
+	SignedBlockHeader           *BlockHeader[BlockHeaderT] `json:"signed_block_header"`
+	KzgCommitmentInclusionProof []common.Root              `json:"kzg_commitment_inclusion_proof"`
 }
 
 type GenesisData struct {
@@ -83,3 +145,11 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+type DepositSnapshotData struct {
+	Finalized            []common.Root        `json:"finalized"`
+	DepositRoot          common.Root          `json:"deposit_root"`
+	DepositCount         uint64               `json:"deposit_count,string"`
+	ExecutionBlockHash   common.ExecutionHash `json:"execution_block_hash"`
+	ExecutionBlockHeight uint64               `json:"execution_block_height,string"`
+}
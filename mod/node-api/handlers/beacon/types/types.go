@@ -20,9 +20,42 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+)
+
+// BeaconBlock is the interface for the beacon block.
+type BeaconBlock interface {
+	constraints.SSZMarshaler
+	Version() uint32
+}
 
 // BeaconBlockHeader is the interface for the beacon block header.
 type BeaconBlockHeader interface {
 	GetBodyRoot() common.Root
 }
+
+// BlobSidecar is the interface for a blob sidecar.
+type BlobSidecar[BeaconBlockHeaderT any] interface {
+	constraints.SSZMarshaler
+	GetIndex() uint64
+	GetBlob() eip4844.Blob
+	GetKzgCommitment() eip4844.KZGCommitment
+	GetKzgProof() eip4844.KZGProof
+	GetBeaconBlockHeader() BeaconBlockHeaderT
+	GetInclusionProof() []common.Root
+}
+
+// BlobSidecars is the interface for the blob sidecars of a block.
+type BlobSidecars[BlobSidecarT any] interface {
+	GetSidecars() []BlobSidecarT
+}
+
+// VoluntaryExit is the interface for a signed voluntary exit submitted to the
+// pool.
+type VoluntaryExit[VoluntaryExitT any] interface {
+	constraints.Empty[VoluntaryExitT]
+	constraints.SSZUnmarshaler
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	configtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetForkSchedule returns every fork of the chain, starting with the fork
+// active at genesis. The schedule is derived from the active fork version
+// at genesis and at each fork epoch of the chain spec, so that forks which
+// do not change the active version are omitted.
+func (h *Handler[ContextT]) GetForkSchedule(ContextT) (any, error) {
+	var (
+		previous = h.cs.ActiveForkVersionForEpoch(0)
+		schedule = []*configtypes.ForkData{{
+			PreviousVersion: forkVersion(previous),
+			CurrentVersion:  forkVersion(previous),
+			Epoch:           0,
+		}}
+	)
+	for _, epoch := range []math.Epoch{
+		h.cs.DenebPlusForkEpoch(),
+		h.cs.ElectraForkEpoch(),
+	} {
+		current := h.cs.ActiveForkVersionForEpoch(epoch)
+		if current == previous {
+			continue
+		}
+		schedule = append(schedule, &configtypes.ForkData{
+			PreviousVersion: forkVersion(previous),
+			CurrentVersion:  forkVersion(current),
+			Epoch:           epoch.Unwrap(),
+		})
+		previous = current
+	}
+	return types.Wrap(schedule), nil
+}
@@ -23,17 +23,24 @@ package config
 import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
+// Handler is the handler for the config API.
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	cs common.ChainSpec
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+// NewHandler creates a new handler for the config API.
+func NewHandler[ContextT context.Context](
+	cs common.ChainSpec,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		cs: cs,
 	}
 	return h
 }
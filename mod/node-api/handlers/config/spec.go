@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GetSpec returns the chain spec, keyed by the standard beacon-API names.
+func (h *Handler[ContextT]) GetSpec(ContextT) (any, error) {
+	cs := h.cs
+	spec := map[string]string{
+		// Gwei values.
+		"MIN_DEPOSIT_AMOUNT":          uintString(cs.MinDepositAmount()),
+		"MAX_EFFECTIVE_BALANCE":       uintString(cs.MaxEffectiveBalance()),
+		"EJECTION_BALANCE":            uintString(cs.EjectionBalance()),
+		"EFFECTIVE_BALANCE_INCREMENT": uintString(cs.EffectiveBalanceIncrement()),
+		// Time parameters.
+		"SLOTS_PER_EPOCH":           uintString(cs.SlotsPerEpoch()),
+		"SLOTS_PER_HISTORICAL_ROOT": uintString(cs.SlotsPerHistoricalRoot()),
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY": uintString(
+			cs.MinEpochsToInactivityPenalty(),
+		),
+		// Signature domains.
+		"DOMAIN_BEACON_PROPOSER":     cs.DomainTypeProposer().String(),
+		"DOMAIN_BEACON_ATTESTER":     cs.DomainTypeAttester().String(),
+		"DOMAIN_RANDAO":              cs.DomainTypeRandao().String(),
+		"DOMAIN_DEPOSIT":             cs.DomainTypeDeposit().String(),
+		"DOMAIN_VOLUNTARY_EXIT":      cs.DomainTypeVoluntaryExit().String(),
+		"DOMAIN_SELECTION_PROOF":     cs.DomainTypeSelectionProof().String(),
+		"DOMAIN_AGGREGATE_AND_PROOF": cs.DomainTypeAggregateAndProof().String(),
+		"DOMAIN_APPLICATION_MASK":    cs.DomainTypeApplicationMask().String(),
+		// Eth1 values.
+		"DEPOSIT_CONTRACT_ADDRESS": cs.DepositContractAddress().Hex(),
+		"DEPOSIT_CHAIN_ID":         uintString(cs.DepositEth1ChainID()),
+		"DEPOSIT_NETWORK_ID":       uintString(cs.DepositEth1ChainID()),
+		"MAX_DEPOSITS":             uintString(cs.MaxDepositsPerBlock()),
+		"ETH1_FOLLOW_DISTANCE":     uintString(cs.Eth1FollowDistance()),
+		"SECONDS_PER_ETH1_BLOCK":   uintString(cs.TargetSecondsPerEth1Block()),
+		// Fork values.
+		"GENESIS_FORK_VERSION": forkVersion(
+			cs.ActiveForkVersionForEpoch(0),
+		).String(),
+		"DENEB_PLUS_FORK_VERSION": forkVersion(version.DenebPlus).String(),
+		"DENEB_PLUS_FORK_EPOCH":   cs.DenebPlusForkEpoch().Base10(),
+		"ELECTRA_FORK_VERSION":    forkVersion(version.Electra).String(),
+		"ELECTRA_FORK_EPOCH":      cs.ElectraForkEpoch().Base10(),
+		// State list lengths.
+		"EPOCHS_PER_HISTORICAL_VECTOR": uintString(
+			cs.EpochsPerHistoricalVector(),
+		),
+		"EPOCHS_PER_SLASHINGS_VECTOR": uintString(
+			cs.EpochsPerSlashingsVector(),
+		),
+		"HISTORICAL_ROOTS_LIMIT":   uintString(cs.HistoricalRootsLimit()),
+		"VALIDATOR_REGISTRY_LIMIT": uintString(cs.ValidatorRegistryLimit()),
+		// Rewards and penalties.
+		"BASE_REWARD_FACTOR": uintString(cs.BaseRewardFactor()),
+		"INACTIVITY_PENALTY_QUOTIENT": uintString(
+			cs.InactivityPenaltyQuotient(),
+		),
+		"PROPORTIONAL_SLASHING_MULTIPLIER": uintString(
+			cs.ProportionalSlashingMultiplier(),
+		),
+		"MIN_SLASHING_PENALTY_QUOTIENT": uintString(
+			cs.MinSlashingPenaltyQuotient(),
+		),
+		"WHISTLEBLOWER_REWARD_QUOTIENT": uintString(
+			cs.WhistleblowerRewardQuotient(),
+		),
+		// Capella values.
+		"MAX_WITHDRAWALS_PER_PAYLOAD": uintString(
+			cs.MaxWithdrawalsPerPayload(),
+		),
+		"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP": uintString(
+			cs.MaxValidatorsPerWithdrawalsSweep(),
+		),
+		// Deneb values.
+		"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS": uintString(
+			cs.MinEpochsForBlobsSidecarsRequest(),
+		),
+		"MAX_BLOB_COMMITMENTS_PER_BLOCK": uintString(
+			cs.MaxBlobCommitmentsPerBlock(),
+		),
+		"MAX_BLOBS_PER_BLOCK":     uintString(cs.MaxBlobsPerBlock()),
+		"FIELD_ELEMENTS_PER_BLOB": uintString(cs.FieldElementsPerBlob()),
+		"BYTES_PER_BLOB":          uintString(cs.BytesPerBlob()),
+	}
+	return types.Wrap(spec), nil
+}
+
+// forkVersion returns the fork version for the given version number.
+func forkVersion(v uint32) common.Version {
+	return version.FromUint32[common.Version](v)
+}
+
+// uintString formats v in base 10, as all spec values are strings.
+func uintString(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	debugtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GetState returns the full beacon state for the given state id.
+func (h *Handler[ContextT, _, _]) GetState(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[debugtypes.GetStateRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID)
+	if err != nil {
+		return nil, err
+	}
+	st, slot, err := h.backend.StateAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	data, err := st.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	return debugtypes.StateResponse{
+		Version: version.Name(
+			h.backend.ChainSpec().ActiveForkVersionForSlot(slot),
+		),
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,
+		Data:                data,
+	}, nil
+}
+
+// GetHeads returns the heads of the chain. Blocks are final as soon as they
+// are committed by CometBFT, so the only head is the latest block.
+func (h *Handler[ContextT, _, _]) GetHeads(ContextT) (any, error) {
+	_, slot, err := h.backend.StateAtSlot(utils.Head)
+	if err != nil {
+		return nil, err
+	}
+	root, err := h.backend.BlockRootAtSlot(utils.Head)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap([]*debugtypes.HeadData{{
+		Root:                root,
+		Slot:                slot.Unwrap(),
+		ExecutionOptimistic: false, // stubbed
+	}}), nil
+}
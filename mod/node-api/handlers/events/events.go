@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	eventstypes "github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// supportedTopics is the set of topics that can be subscribed to.
+//
+//nolint:gochecknoglobals // read-only lookup table.
+var supportedTopics = map[string]struct{}{
+	eventstypes.TopicHead:                {},
+	eventstypes.TopicBlock:               {},
+	eventstypes.TopicBlobSidecar:         {},
+	eventstypes.TopicFinalizedCheckpoint: {},
+	eventstypes.TopicValidatorSetUpdated: {},
+}
+
+// GetEvents subscribes the client to the requested topics and returns a
+// stream of server-sent events that lives until the client disconnects.
+func (h *Handler[
+	BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT, ContextT,
+]) GetEvents(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[eventstypes.GetEventsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := parseTopics(req.Topics)
+	if err != nil {
+		return nil, err
+	}
+	return &stream[
+		BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT, ContextT,
+	]{
+		h:      h,
+		topics: topics,
+	}, nil
+}
+
+// parseTopics parses the requested topics, which may either be given as
+// repeated query parameters or as a single comma-separated list.
+func parseTopics(requested []string) (map[string]struct{}, error) {
+	topics := make(map[string]struct{})
+	for _, param := range requested {
+		for _, topic := range strings.Split(param, ",") {
+			topic = strings.TrimSpace(topic)
+			if _, ok := supportedTopics[topic]; !ok {
+				return nil, errors.Wrapf(
+					types.ErrInvalidRequest, "unsupported topic: %s", topic,
+				)
+			}
+			topics[topic] = struct{}{}
+		}
+	}
+	return topics, nil
+}
@@ -21,19 +21,58 @@
 package events
 
 import (
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
-type Handler[ContextT context.Context] struct {
+// Handler is the handler for the events API.
+type Handler[
+	BeaconBlockT BeaconBlock,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarT],
+	ContextT context.Context,
+] struct {
 	*handlers.BaseHandler[ContextT]
+	// cs is the chain spec, used to derive epochs from slots.
+	cs common.ChainSpec
+	// blkBroker is the feed of beacon block events.
+	blkBroker EventFeed[*asynctypes.Event[BeaconBlockT]]
+	// sidecarsBroker is the feed of blob sidecars events.
+	sidecarsBroker EventFeed[*asynctypes.Event[BlobSidecarsT]]
+	// valUpdateBroker is the feed of validator set updates.
+	valUpdateBroker EventFeed[*asynctypes.Event[transition.ValidatorUpdates]]
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
-	h := &Handler[ContextT]{
+// NewHandler creates a new handler for the events API.
+func NewHandler[
+	BeaconBlockT BeaconBlock,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarT],
+	ContextT context.Context,
+](
+	cs common.ChainSpec,
+	blkBroker EventFeed[*asynctypes.Event[BeaconBlockT]],
+	sidecarsBroker EventFeed[*asynctypes.Event[BlobSidecarsT]],
+	//nolint:lll // annoying formatter.
+	valUpdateBroker EventFeed[*asynctypes.Event[transition.ValidatorUpdates]],
+) *Handler[
+	BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT, ContextT,
+] {
+	h := &Handler[
+		BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT, ContextT,
+	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		cs:              cs,
+		blkBroker:       blkBroker,
+		sidecarsBroker:  sidecarsBroker,
+		valUpdateBroker: valUpdateBroker,
 	}
 	return h
 }
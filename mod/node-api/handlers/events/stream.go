@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
+	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	beaconevents "github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+const (
+	// keepAliveInterval is the interval at which a comment is written to
+	// the stream to keep idle connections from being closed by proxies.
+	keepAliveInterval = 10 * time.Second
+	// streamBufferSize is the number of events buffered per subscription.
+	// Newer events are dropped for a client that falls further behind.
+	streamBufferSize = 64
+)
+
+// subscriptionOptions returns the broker options for a stream subscription
+// to the given event types. Slow clients must never hold up the broker.
+func subscriptionOptions(
+	eventIDs ...asynctypes.EventID,
+) []broker.SubscriptionOption {
+	return []broker.SubscriptionOption{
+		broker.WithEventIDs(eventIDs...),
+		broker.WithPolicy(broker.PolicyDropNewest),
+		broker.WithBufferSize(streamBufferSize),
+	}
+}
+
+// stream is a server-sent events stream for a single client.
+type stream[
+	BeaconBlockT BeaconBlock,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarT],
+	ContextT apicontext.Context,
+] struct {
+	h *Handler[
+		BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT, ContextT,
+	]
+	// topics is the set of topics the client subscribed to.
+	topics map[string]struct{}
+	// lastSidecarsRoot is the root of the last block for which blob sidecar
+	// events were emitted. Sidecars are processed both when verifying and
+	// when finalizing a block, but should only be emitted once.
+	lastSidecarsRoot common.Root
+}
+
+// ContentType implements types.StreamResponse.
+func (*stream[_, _, _, _, _]) ContentType() string {
+	return "text/event-stream"
+}
+
+// Stream implements types.StreamResponse. It subscribes to the brokers
+// backing the requested topics and writes events to w until ctx is done or
+// a broker closes the subscription, at which point all subscriptions are
+// released.
+//
+//nolint:gocognit // one case per broker.
+func (s *stream[
+	BeaconBlockT, _, _, BlobSidecarsT, _,
+]) Stream(
+	ctx context.Context, w io.Writer, flush func(),
+) error {
+	var (
+		// A nil channel is never selected, so brokers that back none of
+		// the requested topics are simply not subscribed to.
+		blkSub      chan *asynctypes.Event[BeaconBlockT]
+		sidecarsSub chan *asynctypes.Event[BlobSidecarsT]
+		valSub      chan *asynctypes.Event[transition.ValidatorUpdates]
+		err         error
+	)
+	if s.wants(
+		types.TopicHead, types.TopicBlock, types.TopicFinalizedCheckpoint,
+	) {
+		if blkSub, err = s.h.blkBroker.SubscribeWithOptions(
+			subscriptionOptions(beaconevents.BeaconBlockFinalized)...,
+		); err != nil {
+			return err
+		}
+		defer s.h.blkBroker.Unsubscribe(blkSub)
+	}
+	if s.wants(types.TopicBlobSidecar) {
+		if sidecarsSub, err = s.h.sidecarsBroker.SubscribeWithOptions(
+			subscriptionOptions(beaconevents.BlobSidecarsProcessed)...,
+		); err != nil {
+			return err
+		}
+		defer s.h.sidecarsBroker.Unsubscribe(sidecarsSub)
+	}
+	if s.wants(types.TopicValidatorSetUpdated) {
+		if valSub, err = s.h.valUpdateBroker.SubscribeWithOptions(
+			subscriptionOptions(beaconevents.ValidatorSetUpdated)...,
+		); err != nil {
+			return err
+		}
+		defer s.h.valUpdateBroker.Unsubscribe(valSub)
+	}
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		var events []*types.Event
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-blkSub:
+			if !ok {
+				return nil
+			}
+			events = s.blockEvents(msg)
+		case msg, ok := <-sidecarsSub:
+			if !ok {
+				return nil
+			}
+			events = s.blobSidecarEvents(msg)
+		case msg, ok := <-valSub:
+			if !ok {
+				return nil
+			}
+			events = s.validatorSetEvents(msg)
+		case <-keepAlive.C:
+			if _, err = io.WriteString(w, ":\n\n"); err != nil {
+				return err
+			}
+		}
+		for _, event := range events {
+			if err = writeEvent(w, event); err != nil {
+				return err
+			}
+		}
+		flush()
+	}
+}
+
+// wants returns true if the client subscribed to any of the given topics.
+func (s *stream[_, _, _, _, _]) wants(topics ...string) bool {
+	for _, topic := range topics {
+		if _, ok := s.topics[topic]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// blockEvents converts a finalized beacon block into head, block and
+// finalized checkpoint events. Since blocks are final as soon as they are
+// committed, the first block of every epoch is the finalized checkpoint.
+func (s *stream[BeaconBlockT, _, _, _, _]) blockEvents(
+	msg *asynctypes.Event[BeaconBlockT],
+) []*types.Event {
+	if msg.Error() != nil {
+		return nil
+	}
+
+	var (
+		blk             = msg.Data()
+		slot            = blk.GetSlot()
+		root            = blk.HashTreeRoot()
+		epochTransition = slot.Unwrap()%s.h.cs.SlotsPerEpoch() == 0
+		events          = make([]*types.Event, 0)
+	)
+	if s.wants(types.TopicHead) {
+		events = append(events, &types.Event{
+			Topic: types.TopicHead,
+			Data: &types.HeadData{
+				Slot:            slot.Unwrap(),
+				Block:           root,
+				State:           blk.GetStateRoot(),
+				EpochTransition: epochTransition,
+			},
+		})
+	}
+	if s.wants(types.TopicBlock) {
+		events = append(events, &types.Event{
+			Topic: types.TopicBlock,
+			Data: &types.BlockData{
+				Slot:  slot.Unwrap(),
+				Block: root,
+			},
+		})
+	}
+	if epochTransition && s.wants(types.TopicFinalizedCheckpoint) {
+		events = append(events, &types.Event{
+			Topic: types.TopicFinalizedCheckpoint,
+			Data: &types.FinalizedCheckpointData{
+				Block: root,
+				State: blk.GetStateRoot(),
+				Epoch: s.h.cs.SlotToEpoch(slot).Unwrap(),
+			},
+		})
+	}
+	return events
+}
+
+// blobSidecarEvents converts successfully processed blob sidecars into one
+// blob sidecar event per blob.
+func (s *stream[_, _, _, BlobSidecarsT, _]) blobSidecarEvents(
+	msg *asynctypes.Event[BlobSidecarsT],
+) []*types.Event {
+	if msg.Error() != nil || msg.Data().IsNil() {
+		return nil
+	}
+
+	sidecars := msg.Data().GetSidecars()
+	if len(sidecars) == 0 {
+		return nil
+	}
+	blockRoot := sidecars[0].GetBeaconBlockHeader().HashTreeRoot()
+	if blockRoot == s.lastSidecarsRoot {
+		return nil
+	}
+	s.lastSidecarsRoot = blockRoot
+
+	events := make([]*types.Event, 0, len(sidecars))
+	for _, sidecar := range sidecars {
+		commitment := sidecar.GetKzgCommitment()
+		events = append(events, &types.Event{
+			Topic: types.TopicBlobSidecar,
+			Data: &types.BlobSidecarData{
+				BlockRoot:     blockRoot,
+				Index:         sidecar.GetIndex(),
+				Slot:          sidecar.GetBeaconBlockHeader().GetSlot().Unwrap(),
+				KzgCommitment: commitment,
+				VersionedHash: commitment.ToVersionedHash(),
+			},
+		})
+	}
+	return events
+}
+
+// validatorSetEvents converts a non-empty validator set update into a
+// validator set updated event.
+func (s *stream[_, _, _, _, _]) validatorSetEvents(
+	msg *asynctypes.Event[transition.ValidatorUpdates],
+) []*types.Event {
+	if msg.Error() != nil || len(msg.Data()) == 0 {
+		return nil
+	}
+
+	updates := make([]*types.ValidatorUpdateData, 0, len(msg.Data()))
+	for _, update := range msg.Data() {
+		updates = append(updates, &types.ValidatorUpdateData{
+			Pubkey:           update.Pubkey,
+			EffectiveBalance: update.EffectiveBalance.Unwrap(),
+		})
+	}
+	return []*types.Event{{
+		Topic: types.TopicValidatorSetUpdated,
+		Data:  &types.ValidatorSetUpdatedData{Updates: updates},
+	}}
+}
+
+// writeEvent writes a single server-sent event to w.
+func writeEvent(w io.Writer, event *types.Event) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Topic, data)
+	return err
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock interface {
+	constraints.SSZRootable
+	// GetSlot returns the slot of the beacon block.
+	GetSlot() math.Slot
+	// GetStateRoot returns the state root of the beacon block.
+	GetStateRoot() common.Root
+}
+
+// BeaconBlockHeader is the interface for a beacon block header.
+type BeaconBlockHeader interface {
+	constraints.SSZRootable
+	// GetSlot returns the slot of the beacon block header.
+	GetSlot() math.Slot
+}
+
+// BlobSidecar is the interface for a blob sidecar.
+type BlobSidecar[BeaconBlockHeaderT BeaconBlockHeader] interface {
+	// GetIndex returns the index of the blob in the block.
+	GetIndex() uint64
+	// GetKzgCommitment returns the KZG commitment of the blob.
+	GetKzgCommitment() eip4844.KZGCommitment
+	// GetBeaconBlockHeader returns the header of the block the blob is
+	// included in.
+	GetBeaconBlockHeader() BeaconBlockHeaderT
+}
+
+// BlobSidecars is the interface for a set of blob sidecars.
+type BlobSidecars[BlobSidecarT any] interface {
+	constraints.Nillable
+	// GetSidecars returns the sidecars.
+	GetSidecars() []BlobSidecarT
+}
+
+// EventFeed is the interface for a feed of events that can be subscribed to
+// and unsubscribed from.
+type EventFeed[EventT any] interface {
+	// SubscribeWithOptions returns a channel that will receive events,
+	// configured by the given options.
+	SubscribeWithOptions(
+		opts ...broker.SubscriptionOption,
+	) (chan EventT, error)
+	// Unsubscribe removes the given channel from the feed and closes it.
+	Unsubscribe(chan EventT)
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+)
+
+// Topics supported by the `/eth/v1/events` endpoint.
+const (
+	TopicHead                = "head"
+	TopicBlock               = "block"
+	TopicBlobSidecar         = "blob_sidecar"
+	TopicFinalizedCheckpoint = "finalized_checkpoint"
+	// TopicValidatorSetUpdated is specific to beacon-kit and is emitted
+	// whenever the validator set sent to CometBFT changes.
+	TopicValidatorSetUpdated = "validator_set_updated"
+)
+
+// Event is a single server-sent event.
+type Event struct {
+	Topic string
+	Data  any
+}
+
+type HeadData struct {
+	Slot                uint64      `json:"slot,string"`
+	Block               common.Root `json:"block"`
+	State               common.Root `json:"state"`
+	EpochTransition     bool        `json:"epoch_transition"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+type BlockData struct {
+	Slot                uint64      `json:"slot,string"`
+	Block               common.Root `json:"block"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+type BlobSidecarData struct {
+	BlockRoot     common.Root           `json:"block_root"`
+	Index         uint64                `json:"index,string"`
+	Slot          uint64                `json:"slot,string"`
+	KzgCommitment eip4844.KZGCommitment `json:"kzg_commitment"`
+	VersionedHash common.Bytes32        `json:"versioned_hash"`
+}
+
+type FinalizedCheckpointData struct {
+	Block               common.Root `json:"block"`
+	State               common.Root `json:"state"`
+	Epoch               uint64      `json:"epoch,string"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+type ValidatorSetUpdatedData struct {
+	Updates []*ValidatorUpdateData `json:"updates"`
+}
+
+type ValidatorUpdateData struct {
+	Pubkey           crypto.BLSPubkey `json:"pubkey"`
+	EffectiveBalance uint64           `json:"effective_balance,string"`
+}
@@ -25,15 +25,19 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
+// Handler is the handler for the node API.
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	backend Backend
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+// NewHandler creates a new handler for the node API.
+func NewHandler[ContextT context.Context](backend Backend) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler[ContextT](
 			handlers.NewRouteSet[ContextT](""),
 		),
+		backend: backend,
 	}
 	return h
 }
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"slices"
+
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetPeers returns the peers of the node, filtered by the requested states
+// and directions.
+func (h *Handler[ContextT]) GetPeers(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.GetPeersRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	peers, err := h.backend.NodePeers(c.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]*nodetypes.PeerData, 0, len(peers))
+	for _, peer := range peers {
+		if len(req.States) > 0 && !slices.Contains(req.States, peer.State) {
+			continue
+		}
+		if len(req.Directions) > 0 &&
+			!slices.Contains(req.Directions, peer.Direction) {
+			continue
+		}
+		filtered = append(filtered, peer)
+	}
+	return nodetypes.PeersResponse{
+		Data: filtered,
+		Meta: nodetypes.PeersMetadata{Count: uint64(len(filtered))},
+	}, nil
+}
+
+// GetPeer returns a single peer of the node by its peer id.
+func (h *Handler[ContextT]) GetPeer(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.GetPeerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	peers, err := h.backend.NodePeers(c.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+	for _, peer := range peers {
+		if peer.PeerID == req.PeerID {
+			return types.Wrap(peer), nil
+		}
+	}
+	return nil, types.ErrNotFound
+}
+
+// GetPeerCount returns the number of peers of the node by connection state.
+func (h *Handler[ContextT]) GetPeerCount(c ContextT) (any, error) {
+	peers, err := h.backend.NodePeers(c.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+	// The consensus engine only reports peers it holds a connection to.
+	return types.Wrap(nodetypes.PeerCountData{
+		Connected: uint64(len(peers)),
+	}), nil
+}
@@ -35,37 +35,37 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/identity",
-			Handler: h.NotImplemented,
+			Handler: h.GetIdentity,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers",
-			Handler: h.NotImplemented,
+			Handler: h.GetPeers,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers/:peer_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetPeer,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers/peer_count",
-			Handler: h.NotImplemented,
+			Handler: h.GetPeerCount,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/version",
-			Handler: h.NotImplemented,
+			Handler: h.GetVersion,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/syncing",
-			Handler: h.NotImplemented,
+			Handler: h.GetSyncing,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/health",
-			Handler: h.NotImplemented,
+			Handler: h.GetHealth,
 		},
 	})
 }
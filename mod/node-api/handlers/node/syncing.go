@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"net/http"
+
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetSyncing returns the sync status of the node.
+func (h *Handler[ContextT]) GetSyncing(c ContextT) (any, error) {
+	syncing, err := h.backend.NodeSyncing(c.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(syncing), nil
+}
+
+// GetHealth returns the health of the node as a bare status code: 200 if the
+// node is ready, 206 (or the requested syncing status) while it is syncing and
+// 503 if the sync status cannot be determined.
+func (h *Handler[ContextT]) GetHealth(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.GetHealthRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	syncing, err := h.backend.NodeSyncing(c.Request().Context())
+	switch {
+	case err != nil:
+		h.Logger().Debug("node is unhealthy", "error", err)
+		return types.StatusResponse{Code: http.StatusServiceUnavailable}, nil
+	case !syncing.IsSyncing:
+		return types.StatusResponse{Code: http.StatusOK}, nil
+	case req.SyncingStatus != 0:
+		return types.StatusResponse{Code: req.SyncingStatus}, nil
+	default:
+		return types.StatusResponse{Code: http.StatusPartialContent}, nil
+	}
+}
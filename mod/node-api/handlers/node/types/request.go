@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// GetPeersRequest is the request for the peers of the node, optionally
+// filtered by connection state and direction.
+type GetPeersRequest struct {
+	States     []string `query:"state"     validate:"dive,oneof=disconnected connecting connected disconnecting"`
+	Directions []string `query:"direction" validate:"dive,oneof=inbound outbound"`
+}
+
+// GetPeerRequest is the request for a single peer of the node.
+type GetPeerRequest struct {
+	PeerID string `param:"peer_id" validate:"required"`
+}
+
+// GetHealthRequest is the request for the health of the node. SyncingStatus
+// overrides the status code returned while the node is syncing.
+type GetHealthRequest struct {
+	SyncingStatus int `query:"syncing_status" validate:"omitempty,min=100,max=599"`
+}
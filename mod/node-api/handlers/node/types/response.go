@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+const (
+	// PeerStateConnected is the state of a peer with an open connection.
+	PeerStateConnected = "connected"
+
+	// PeerDirectionInbound is the direction of a peer that dialed the node.
+	PeerDirectionInbound = "inbound"
+	// PeerDirectionOutbound is the direction of a peer the node dialed.
+	PeerDirectionOutbound = "outbound"
+)
+
+// IdentityData is the p2p identity of the node.
+type IdentityData struct {
+	PeerID             string           `json:"peer_id"`
+	ENR                string           `json:"enr"`
+	P2PAddresses       []string         `json:"p2p_addresses"`
+	DiscoveryAddresses []string         `json:"discovery_addresses"`
+	Metadata           IdentityMetadata `json:"metadata"`
+}
+
+// IdentityMetadata is the p2p metadata of the node. The consensus engine does
+// not gossip attestation subnets, so it is always empty.
+type IdentityMetadata struct {
+	SeqNumber uint64 `json:"seq_number,string"`
+	Attnets   string `json:"attnets"`
+}
+
+// PeerData is a single peer of the node.
+type PeerData struct {
+	PeerID             string `json:"peer_id"`
+	ENR                string `json:"enr"`
+	LastSeenP2PAddress string `json:"last_seen_p2p_address"`
+	State              string `json:"state"`
+	Direction          string `json:"direction"`
+}
+
+// PeerCountData is the number of peers of the node by connection state.
+type PeerCountData struct {
+	Disconnected  uint64 `json:"disconnected,string"`
+	Connecting    uint64 `json:"connecting,string"`
+	Connected     uint64 `json:"connected,string"`
+	Disconnecting uint64 `json:"disconnecting,string"`
+}
+
+// PeersMetadata is the metadata returned alongside the peers of the node.
+type PeersMetadata struct {
+	Count uint64 `json:"count"`
+}
+
+// PeersResponse is the response for the peers of the node.
+type PeersResponse struct {
+	Data []*PeerData   `json:"data"`
+	Meta PeersMetadata `json:"meta"`
+}
+
+// SyncingData is the sync status of the node.
+type SyncingData struct {
+	HeadSlot     uint64 `json:"head_slot,string"`
+	SyncDistance uint64 `json:"sync_distance,string"`
+	IsSyncing    bool   `json:"is_syncing"`
+	IsOptimistic bool   `json:"is_optimistic"`
+	ELOffline    bool   `json:"el_offline"`
+}
+
+// VersionData is the version of the node.
+type VersionData struct {
+	Version string `json:"version"`
+}
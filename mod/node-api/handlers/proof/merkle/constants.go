@@ -30,7 +30,7 @@ const (
 	// GIndex of the pubkey of validator at index n, the formula is:
 	// GIndex = ZeroValidatorPubkeyGIndexDenebState +
 	//          (ValidatorPubkeyGIndexOffset * n)
-	ZeroValidatorPubkeyGIndexDenebState = 439804651110400
+	ZeroValidatorPubkeyGIndexDenebState = 721279627821056
 
 	// ZeroValidatorPubkeyGIndexDenebBlock is the generalized index of the 0
 	// validator's pubkey in the beacon block in the Deneb fork. This is
@@ -39,29 +39,29 @@ const (
 	// validator at index n, the formula is:
 	// GIndex = ZeroValidatorPubkeyGIndexDenebBlock +
 	//          (ValidatorPubkeyGIndexOffset * n)
-	ZeroValidatorPubkeyGIndexDenebBlock = 3254554418216960
+	ZeroValidatorPubkeyGIndexDenebBlock = 6350779162034176
 
 	// ValidatorPubkeyGIndexOffset is the offset of a validator pubkey GIndex.
 	ValidatorPubkeyGIndexOffset = 8
 
 	// ExecutionNumberGIndexDenebState is the generalized index of the latest
 	// execution payload header in the beacon state in the Deneb fork.
-	ExecutionNumberGIndexDenebState = 774
+	ExecutionNumberGIndexDenebState = 1286
 
 	// ExecutionNumberGIndexDenebBlock is the generalized index of the number
 	// in the latest execution payload header in the beacon block in the Deneb
 	// fork. This is calculated by concatenating the
 	// (ExecutionNumberGIndexDenebState, StateGIndexDenebBlock) GIndices.
-	ExecutionNumberGIndexDenebBlock = 5894
+	ExecutionNumberGIndexDenebBlock = 11526
 
 	// ExecutionFeeRecipientGIndexDenebState is the generalized index of the
 	// fee recipient in the latest execution payload header in the beacon state
 	// in the Deneb fork.
-	ExecutionFeeRecipientGIndexDenebState = 769
+	ExecutionFeeRecipientGIndexDenebState = 1281
 
 	// ExecutionFeeRecipientGIndexDenebBlock is the generalized index of the
 	// fee recipient in the latest execution payload header in the beacon block
 	// in the Deneb fork. This is calculated by concatenating the
 	// (ExecutionFeeRecipientGIndexDenebState, StateGIndexDenebBlock) GIndices.
-	ExecutionFeeRecipientGIndexDenebBlock = 5889
+	ExecutionFeeRecipientGIndexDenebBlock = 11521
 )
@@ -42,7 +42,7 @@ type BlockProposerResponse[BeaconBlockHeaderT any] struct {
 	// ValidatorPubkeyProof can be verified against the beacon block root. Use
 	// a Generalized Index of `z + (8 * ValidatorIndex)`, where z is the
 	// Generalized Index of the 0 validator pubkey in the beacon block. In
-	// the Deneb fork, z is 3254554418216960.
+	// the Deneb fork, z is 6350779162034176.
 	ValidatorPubkeyProof []common.Root `json:"validator_pubkey_proof"`
 }
 
@@ -60,7 +60,7 @@ type ExecutionNumberResponse[BeaconBlockHeaderT any] struct {
 	ExecutionNumber math.U64 `json:"execution_number"`
 
 	// ExecutionNumberProof can be verified against the beacon block root using
-	// a Generalized Index of 5894 in the Deneb fork.
+	// a Generalized Index of 11526 in the Deneb fork.
 	ExecutionNumberProof []common.Root `json:"execution_number_proof"`
 }
 
@@ -80,6 +80,6 @@ type ExecutionFeeRecipientResponse[BeaconBlockHeaderT any] struct {
 	ExecutionFeeRecipient common.ExecutionAddress `json:"execution_fee_recipient"`
 
 	// ExecutionFeeRecipientProof can be verified against the beacon block root
-	// using a Generalized Index of 5894 in the Deneb fork.
+	// using a Generalized Index of 11521 in the Deneb fork.
 	ExecutionFeeRecipientProof []common.Root `json:"execution_fee_recipient_proof"`
 }
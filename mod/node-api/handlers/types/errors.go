@@ -23,7 +23,10 @@ package types
 import "errors"
 
 var (
-	ErrNotFound       = errors.New("not found")
-	ErrNotImplemented = errors.New("not implemented")
-	ErrInvalidRequest = errors.New("invalid request")
+	ErrNotFound        = errors.New("not found")
+	ErrNotImplemented  = errors.New("not implemented")
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrSSZNotSupported = errors.New("ssz encoding not supported")
+
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
 )
@@ -20,6 +20,22 @@
 
 package types
 
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+)
+
+const (
+	// ConsensusVersionHeader is the header carrying the fork of the data of
+	// a versioned response.
+	ConsensusVersionHeader = "Eth-Consensus-Version"
+	// SSZContentType is the MIME type of SSZ encoded requests and responses.
+	SSZContentType = "application/octet-stream"
+)
+
 type DataResponse struct {
 	Data any `json:"data"`
 }
@@ -29,3 +45,76 @@ func Wrap(data any) DataResponse {
 		Data: data,
 	}
 }
+
+// MarshalSSZ returns the SSZ encoding of the data of the response.
+func (r DataResponse) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(r.Data)
+}
+
+// MarshalSSZ returns the SSZ encoding of the given response data, or
+// ErrSSZNotSupported if the data has no SSZ encoding.
+func MarshalSSZ(data any) ([]byte, error) {
+	ssz, ok := data.(constraints.SSZMarshaler)
+	if !ok {
+		return nil, ErrSSZNotSupported
+	}
+	return ssz.MarshalSSZ()
+}
+
+// SSZData is response data that is rendered as JSON from one value and as
+// SSZ from another, for when the schema of the API differs from the type
+// the data is stored as.
+type SSZData struct {
+	JSON any
+	SSZ  constraints.SSZMarshaler
+}
+
+// MarshalJSON returns the JSON encoding of the data.
+func (d SSZData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.JSON)
+}
+
+// MarshalSSZ returns the SSZ encoding of the data.
+func (d SSZData) MarshalSSZ() ([]byte, error) {
+	return d.SSZ.MarshalSSZ()
+}
+
+// SSZList is a list of fixed size items, SSZ encoded as the concatenation of
+// the encodings of its items.
+type SSZList[T constraints.SSZMarshaler] []T
+
+// MarshalSSZ returns the SSZ encoding of the list.
+func (l SSZList[T]) MarshalSSZ() ([]byte, error) {
+	var bz []byte
+	for _, item := range l {
+		itemBz, err := item.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		bz = append(bz, itemBz...)
+	}
+	return bz, nil
+}
+
+// StreamResponse is a response that is written to the client incrementally
+// (e.g. server-sent events) rather than being rendered all at once.
+type StreamResponse interface {
+	// ContentType returns the MIME type of the stream.
+	ContentType() string
+	// Stream writes the response to w until ctx is done or the stream ends.
+	// flush is called whenever a complete message has been written.
+	Stream(ctx context.Context, w io.Writer, flush func()) error
+}
+
+// StatusResponse is a response that carries only an HTTP status code and no
+// body.
+type StatusResponse struct {
+	Code int
+}
+
+// VersionedResponse is a response whose encoding depends on the fork of the
+// data it carries.
+type VersionedResponse interface {
+	// ConsensusVersion returns the name of the fork of the data.
+	ConsensusVersion() string
+}
@@ -21,6 +21,7 @@
 package utils
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
@@ -33,6 +34,9 @@ func BindAndValidate[RequestT any, ContextT context.Context](
 ) (RequestT, error) {
 	var req RequestT
 	if err := c.Bind(&req); err != nil {
+		if errors.Is(err, types.ErrUnsupportedMediaType) {
+			return req, err
+		}
 		return req, types.ErrInvalidRequest
 	}
 	if err := c.Validate(&req); err != nil {
@@ -46,10 +46,15 @@ func SlotFromStateID(stateID string) (math.Slot, error) {
 // SlotFromBlockID returns a slot from the block ID.
 //
 // NOTE: `blockID` shares the same semantics as `stateID`, with the modification
-// of being able to query by beacon <blockRoot> instead of <stateRoot>.
+// of being able to query by beacon <blockRoot> instead of <stateRoot>, as well
+// as by <executionNumber> as described in SlotFromExecutionID.
 func SlotFromBlockID[StorageBackendT interface {
 	GetSlotByRoot(root common.Root) (math.Slot, error)
+	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
 }](blockID string, storage StorageBackendT) (math.Slot, error) {
+	if IsExecutionNumberPrefix(blockID) {
+		return SlotFromExecutionID(blockID, storage)
+	}
 	if slot, err := SlotFromStateID(blockID); err == nil {
 		return slot, nil
 	}
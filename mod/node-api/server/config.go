@@ -32,6 +32,9 @@ type Config struct {
 	Address string `mapstructure:"address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// Debug is the flag to enable the debug API endpoints, which can serve
+	// large responses such as full beacon states.
+	Debug bool `mapstructure:"debug"`
 }
 
 // DefaultConfig returns the default configuration for the node API server.
@@ -40,5 +43,6 @@ func DefaultConfig() Config {
 		Enabled: false,
 		Address: defaultAddress,
 		Logging: false,
+		Debug:   false,
 	}
 }
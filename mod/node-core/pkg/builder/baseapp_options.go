@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/store"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
@@ -69,6 +70,18 @@ func WithProcessProposal(
 	}
 }
 
+// WithQueryHandler registers the handler serving the ABCI queries under the
+// given route to the baseapp.
+func WithQueryHandler(
+	route string,
+	storeKey storetypes.StoreKey,
+	handler baseapp.QueryHandler,
+) func(bApp *baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		bApp.SetQueryHandler(route, storeKey, handler)
+	}
+}
+
 // DefaultBaseappOptions returns the default baseapp options provided by the
 // Cosmos SDK.
 func DefaultBaseappOptions(
@@ -85,6 +98,17 @@ func DefaultBaseappOptions(
 		panic(err)
 	}
 
+	// State sync snapshots of the beacon state are taken every
+	// snapshot-interval blocks, keeping the snapshot-keep-recent latest ones.
+	snapshotStore, err := server.GetSnapshotStore(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	snapshotOpts := snapshottypes.NewSnapshotOptions(
+		cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
+		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
+	)
+
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
 	var reader *os.File
@@ -115,6 +139,7 @@ func DefaultBaseappOptions(
 			cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks)),
 		),
 		baseapp.SetInterBlockCache(cache),
+		baseapp.SetSnapshot(snapshotStore, snapshotOpts),
 		baseapp.SetIAVLCacheSize(
 			cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize)),
 		),
@@ -80,6 +80,8 @@ func (nb *NodeBuilder[NodeT]) Build(
 		serviceRegistry *service.Registry
 		consensusEngine *components.ConsensusEngine
 		apiBackend      *components.NodeAPIBackend
+		depositService  *components.DepositService
+		kvStore         *components.KVStore
 		storeKey        = new(storetypes.KVStoreKey)
 		storeKeyDblPtr  = &storeKey
 	)
@@ -104,22 +106,32 @@ func (nb *NodeBuilder[NodeT]) Build(
 		&serviceRegistry,
 		&consensusEngine,
 		&apiBackend,
+		&depositService,
+		&kvStore,
 	); err != nil {
 		panic(err)
 	}
 
 	// set the application to a new BeaconApp with necessary ABCI handlers
-	nb.node.RegisterApp(
-		runtime.NewBeaconKitApp(
-			*storeKeyDblPtr, logger, db, traceStore, true, abciMiddleware,
-			append(
-				DefaultBaseappOptions(appOpts),
-				WithCometParamStore(chainSpec),
-				WithPrepareProposal(consensusEngine.PrepareProposal),
-				WithProcessProposal(consensusEngine.ProcessProposal),
-			)...,
-		),
+	app := runtime.NewBeaconKitApp(
+		*storeKeyDblPtr, logger, db, traceStore, true, abciMiddleware,
+		append(
+			DefaultBaseappOptions(appOpts),
+			WithCometParamStore(chainSpec),
+			WithPrepareProposal(consensusEngine.PrepareProposal),
+			WithProcessProposal(consensusEngine.ProcessProposal),
+			WithQueryHandler("beacon", *storeKeyDblPtr, kvStore.QueryKey),
+		)...,
 	)
+
+	// The deposit store lives outside of the multistore, so it is carried
+	// by the state sync snapshots as an extension.
+	if manager := app.SnapshotManager(); manager != nil {
+		if err := manager.RegisterExtensions(depositService); err != nil {
+			panic(err)
+		}
+	}
+	nb.node.RegisterApp(app)
 	// TODO: so hood
 	apiBackend.AttachNode(nb.node)
 	nb.node.SetServiceRegistry(serviceRegistry)
@@ -42,9 +42,13 @@ func ProvideNodeAPIEngine() *NodeAPIEngine {
 type NodeAPIBackendInput struct {
 	depinject.In
 
-	ChainSpec      common.ChainSpec
-	StateProcessor *StateProcessor
-	StorageBackend *StorageBackend
+	ChainSpec         common.ChainSpec
+	ConsensusClient   *ConsensusClient
+	EngineClient      *EngineClient
+	ReportingService  *ReportingService
+	StateProcessor    *StateProcessor
+	StorageBackend    *StorageBackend
+	VoluntaryExitPool *VoluntaryExitPool
 }
 
 func ProvideNodeAPIBackend(in NodeAPIBackendInput) *NodeAPIBackend {
@@ -68,12 +72,17 @@ func ProvideNodeAPIBackend(in NodeAPIBackendInput) *NodeAPIBackend {
 		*StorageBackend,
 		*Validator,
 		Validators,
+		*VoluntaryExit,
 		*Withdrawal,
 		WithdrawalCredentials,
 	](
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.VoluntaryExitPool,
+		in.ConsensusClient,
+		in.EngineClient,
+		in.ReportingService.Version(),
 	)
 }
 
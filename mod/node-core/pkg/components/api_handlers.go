@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/mod/node-api/handlers/builder"
@@ -30,11 +31,13 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
 type NodeAPIHandlersInput struct {
 	depinject.In
 
+	Config            *config.Config
 	BeaconAPIHandler  *BeaconAPIHandler
 	BuilderAPIHandler *BuilderAPIHandler
 	ConfigAPIHandler  *ConfigAPIHandler
@@ -47,23 +50,31 @@ type NodeAPIHandlersInput struct {
 func ProvideNodeAPIHandlers(
 	in NodeAPIHandlersInput,
 ) []handlers.Handlers[NodeAPIContext] {
-	return []handlers.Handlers[NodeAPIContext]{
+	apiHandlers := []handlers.Handlers[NodeAPIContext]{
 		in.BeaconAPIHandler,
 		in.BuilderAPIHandler,
 		in.ConfigAPIHandler,
-		in.DebugAPIHandler,
 		in.EventsAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
 	}
+	// The debug endpoints are only served if explicitly enabled.
+	if in.Config.NodeAPI.Debug {
+		apiHandlers = append(apiHandlers, in.DebugAPIHandler)
+	}
+	return apiHandlers
 }
 
 func ProvideNodeAPIBeaconHandler(b *NodeAPIBackend) *BeaconAPIHandler {
 	return beaconapi.NewHandler[
+		*BeaconBlock,
 		*BeaconBlockHeader,
+		*BlobSidecar,
+		*BlobSidecars,
 		NodeAPIContext,
 		*Fork,
 		*Validator,
+		*VoluntaryExit,
 	](b)
 }
 
@@ -71,20 +82,44 @@ func ProvideNodeAPIBuilderHandler() *BuilderAPIHandler {
 	return builderapi.NewHandler[NodeAPIContext]()
 }
 
-func ProvideNodeAPIConfigHandler() *ConfigAPIHandler {
-	return configapi.NewHandler[NodeAPIContext]()
+func ProvideNodeAPIConfigHandler(cs common.ChainSpec) *ConfigAPIHandler {
+	return configapi.NewHandler[NodeAPIContext](cs)
+}
+
+func ProvideNodeAPIDebugHandler(b *NodeAPIBackend) *DebugAPIHandler {
+	return debugapi.NewHandler[
+		NodeAPIContext, *BeaconState, *BeaconStateMarshallable,
+	](b)
 }
 
-func ProvideNodeAPIDebugHandler() *DebugAPIHandler {
-	return debugapi.NewHandler[NodeAPIContext]()
+type NodeAPIEventsHandlerInput struct {
+	depinject.In
+
+	BlockBroker           *BlockBroker
+	ChainSpec             common.ChainSpec
+	SidecarsBroker        *SidecarsBroker
+	ValidatorUpdateBroker *ValidatorUpdateBroker
 }
 
-func ProvideNodeAPIEventsHandler() *EventsAPIHandler {
-	return eventsapi.NewHandler[NodeAPIContext]()
+func ProvideNodeAPIEventsHandler(
+	in NodeAPIEventsHandlerInput,
+) *EventsAPIHandler {
+	return eventsapi.NewHandler[
+		*BeaconBlock,
+		*BeaconBlockHeader,
+		*BlobSidecar,
+		*BlobSidecars,
+		NodeAPIContext,
+	](
+		in.ChainSpec,
+		in.BlockBroker,
+		in.SidecarsBroker,
+		in.ValidatorUpdateBroker,
+	)
 }
 
-func ProvideNodeAPINodeHandler() *NodeAPIHandler {
-	return nodeapi.NewHandler[NodeAPIContext]()
+func ProvideNodeAPINodeHandler(b *NodeAPIBackend) *NodeAPIHandler {
+	return nodeapi.NewHandler[NodeAPIContext](b)
 }
 
 func ProvideNodeAPIProofHandler(b *NodeAPIBackend) *ProofAPIHandler {
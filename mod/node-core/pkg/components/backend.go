@@ -56,6 +56,7 @@ func ProvideStorageBackend(
 		*ExecutionPayloadHeader,
 		*Fork,
 		*KVStore,
+		*PendingPartialWithdrawal,
 		*Validator,
 		Validators,
 		*Withdrawal,
@@ -99,6 +99,8 @@ type DAServiceIn struct {
 	AvailabilityStore *AvailabilityStore
 	SidecarsBroker    *SidecarsBroker
 	BlobProcessor     *BlobProcessor
+	EngineClient      *EngineClient
+	SidecarFactory    *SidecarFactory
 	Logger            log.Logger
 }
 
@@ -107,6 +109,7 @@ type DAServiceIn struct {
 func ProvideDAService(in DAServiceIn) *DAService {
 	return da.NewService[
 		*AvailabilityStore,
+		*BeaconBlock,
 		*BeaconBlockBody,
 		*BlobSidecars,
 		*SidecarsBroker,
@@ -114,6 +117,8 @@ func ProvideDAService(in DAServiceIn) *DAService {
 	](
 		in.AvailabilityStore,
 		in.BlobProcessor,
+		in.EngineClient,
+		in.SidecarFactory,
 		in.SidecarsBroker,
 		in.Logger.With("service", "da"),
 	)
@@ -24,9 +24,12 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	blockservice "github.com/berachain/beacon-kit/mod/beacon/block_store"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
@@ -38,7 +41,8 @@ import (
 // BlockStoreInput is the input for the dep inject framework.
 type BlockStoreInput struct {
 	depinject.In
-	AppOpts servertypes.AppOptions
+	AppOpts   servertypes.AppOptions
+	ChainSpec common.ChainSpec
 }
 
 // ProvideBlockStore is a function that provides the module to the
@@ -53,7 +57,9 @@ func ProvideBlockStore(
 		return nil, err
 	}
 
-	return block.NewStore[*BeaconBlock](storage.NewKVStoreProvider(kvp)), nil
+	return block.NewStore[*BeaconBlock](
+		storage.NewKVStoreProvider(kvp), in.ChainSpec,
+	), nil
 }
 
 // BlockPrunerInput is the input for the block pruner.
@@ -70,7 +76,12 @@ type BlockPrunerInput struct {
 func ProvideBlockPruner(
 	in BlockPrunerInput,
 ) (BlockPruner, error) {
-	subCh, err := in.BlockBroker.Subscribe()
+	subCh, err := in.BlockBroker.SubscribeWithOptions(
+		broker.WithEventIDs(events.BeaconBlockFinalized),
+		// Pruning is not time sensitive, a later block prunes what a
+		// dropped one would have.
+		broker.WithPolicy(broker.PolicyDropOldest),
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, err
@@ -21,48 +21,62 @@
 package components
 
 import (
+	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 )
 
+// BrokerInput is the input for the brokers.
+type BrokerInput struct {
+	depinject.In
+	TelemetrySink *metrics.TelemetrySink
+}
+
 // ProvideBlobBroker provides a blob feed for the depinject framework.
-func ProvideBlobBroker() *SidecarsBroker {
+func ProvideBlobBroker(in BrokerInput) *SidecarsBroker {
 	return broker.New[*SidecarEvent](
 		"blob-broker",
+		in.TelemetrySink,
 	)
 }
 
 // ProvideBlockBroker provides a block feed for the depinject framework.
-func ProvideBlockBroker() *BlockBroker {
+func ProvideBlockBroker(in BrokerInput) *BlockBroker {
 	return broker.New[*BlockEvent](
 		"blk-broker",
+		in.TelemetrySink,
 	)
 }
 
 // ProvideGenesisBroker provides a genesis feed for the depinject framework.
-func ProvideGenesisBroker() *GenesisBroker {
+func ProvideGenesisBroker(in BrokerInput) *GenesisBroker {
 	return broker.New[*GenesisEvent](
 		"genesis-broker",
+		in.TelemetrySink,
 	)
 }
 
 // ProvideSlotBroker provides a slot feed for the depinject framework.
-func ProvideSlotBroker() *SlotBroker {
+func ProvideSlotBroker(in BrokerInput) *SlotBroker {
 	return broker.New[*SlotEvent](
 		"slot-broker",
+		in.TelemetrySink,
 	)
 }
 
 // ProvideStatusBroker provides a status feed.
-func ProvideStatusBroker() *StatusBroker {
+func ProvideStatusBroker(in BrokerInput) *StatusBroker {
 	return broker.New[*StatusEvent](
 		"status-broker",
+		in.TelemetrySink,
 	)
 }
 
 // ProvideValidatorUpdateBroker provides a validator updates feed.
-func ProvideValidatorUpdateBroker() *ValidatorUpdateBroker {
+func ProvideValidatorUpdateBroker(in BrokerInput) *ValidatorUpdateBroker {
 	return broker.New[*ValidatorUpdateEvent](
 		"validator-updates-broker",
+		in.TelemetrySink,
 	)
 }
 
@@ -38,6 +38,7 @@ type ChainServiceInput struct {
 	BlockBroker           *BlockBroker
 	ChainSpec             common.ChainSpec
 	Cfg                   *config.Config
+	DAService             *DAService
 	DepositService        *DepositService
 	EngineClient          *EngineClient
 	ExecutionEngine       *ExecutionEngine
@@ -69,6 +70,8 @@ func ProvideChainService(
 		*Withdrawal,
 	](
 		in.StorageBackend,
+		in.DAService,
+		in.DepositService,
 		in.Logger.With("service", "blockchain"),
 		in.ChainSpec,
 		in.ExecutionEngine,
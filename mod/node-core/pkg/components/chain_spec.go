@@ -21,26 +21,20 @@
 package components
 
 import (
-	"os"
-
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
-const (
-	ChainSpecTypeEnvVar = "CHAIN_SPEC"
-	DevnetChainSpecType = "devnet"
-)
-
-// ProvideChainSpec provides the chain spec based on the environment variable.
-func ProvideChainSpec() common.ChainSpec {
-	// TODO: This is hood as fuck needs to be improved
-	// but for now we ball to get CI unblocked.
-	specType := os.Getenv(ChainSpecTypeEnvVar)
-	chainSpec := spec.TestnetChainSpec()
-	if specType == DevnetChainSpecType {
-		chainSpec = spec.DevnetChainSpec()
-	}
+// ChainSpecInput is the input for the chain spec provider.
+type ChainSpecInput struct {
+	depinject.In
+	Config *config.Config
+}
 
-	return chainSpec
+// ProvideChainSpec provides the chain spec selected by the config, either a
+// built-in preset or one loaded from a chain spec file.
+func ProvideChainSpec(in ChainSpecInput) (common.ChainSpec, error) {
+	return spec.Load(in.Config.ChainSpec)
 }
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package consensus
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	"github.com/cometbft/cometbft/p2p"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// emptyAttnets is the attestation subnet bitvector reported in the node
+// metadata, since the consensus engine does not subscribe to any.
+const emptyAttnets = "0x0000000000000000"
+
+// Client queries the p2p and sync status of the CometBFT node through its
+// RPC server.
+type Client struct {
+	rpc *rpchttp.HTTP
+}
+
+// NewClient creates a new client for the CometBFT RPC server listening on
+// the given address.
+func NewClient(addr string) (*Client, error) {
+	rpc, err := rpchttp.New(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{rpc: rpc}, nil
+}
+
+// Identity returns the p2p identity of the node.
+func (c *Client) Identity(
+	ctx context.Context,
+) (*nodetypes.IdentityData, error) {
+	status, err := c.rpc.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	addr := p2p.IDAddressString(
+		status.NodeInfo.ID(), status.NodeInfo.ListenAddr,
+	)
+	return &nodetypes.IdentityData{
+		PeerID:             string(status.NodeInfo.ID()),
+		P2PAddresses:       []string{addr},
+		DiscoveryAddresses: []string{addr},
+		Metadata: nodetypes.IdentityMetadata{
+			Attnets: emptyAttnets,
+		},
+	}, nil
+}
+
+// Peers returns the peers the node is connected to.
+func (c *Client) Peers(ctx context.Context) ([]*nodetypes.PeerData, error) {
+	netInfo, err := c.rpc.NetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	peers := make([]*nodetypes.PeerData, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		peers = append(peers, peerData(peer))
+	}
+	return peers, nil
+}
+
+// SyncInfo returns the latest height of the node and whether it is still
+// catching up with the network.
+func (c *Client) SyncInfo(ctx context.Context) (uint64, bool, error) {
+	status, err := c.rpc.Status(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	//#nosec:G701 // block heights are never negative.
+	return uint64(status.SyncInfo.LatestBlockHeight),
+		status.SyncInfo.CatchingUp, nil
+}
+
+// peerData converts a CometBFT peer into its node API representation. The
+// address of the peer is the remote IP it was last seen on, combined with
+// the port it advertises.
+func peerData(peer ctypes.Peer) *nodetypes.PeerData {
+	id := peer.NodeInfo.ID()
+	addr := peer.NodeInfo.ListenAddr
+	if _, hostPort, found := strings.Cut(addr, "://"); found {
+		addr = hostPort
+	}
+	_, port, err := net.SplitHostPort(addr)
+	if err == nil && peer.RemoteIP != "" {
+		addr = net.JoinHostPort(peer.RemoteIP, port)
+	}
+
+	direction := nodetypes.PeerDirectionInbound
+	if peer.IsOutbound {
+		direction = nodetypes.PeerDirectionOutbound
+	}
+	return &nodetypes.PeerData{
+		PeerID:             string(id),
+		LastSeenP2PAddress: p2p.IDAddressString(id, addr),
+		State:              nodetypes.PeerStateConnected,
+		Direction:          direction,
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/consensus"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// rpcListenAddr is the key of the CometBFT RPC listen address in the node
+// configuration.
+const rpcListenAddr = "rpc.laddr"
+
+// ConsensusClientInput is the input for the dep inject framework.
+type ConsensusClientInput struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+}
+
+// ProvideConsensusClient provides a client for the RPC server of the local
+// CometBFT node, falling back to the default listen address if none is
+// configured.
+func ProvideConsensusClient(
+	in ConsensusClientInput,
+) (*ConsensusClient, error) {
+	addr := cast.ToString(in.AppOpts.Get(rpcListenAddr))
+	if addr == "" {
+		addr = cmtcfg.DefaultRPCConfig().ListenAddress
+	}
+	return consensus.NewClient(addr)
+}
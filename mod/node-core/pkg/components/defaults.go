@@ -37,6 +37,7 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideChainService,
 		ProvideChainSpec,
 		ProvideConfig,
+		ProvideConsensusClient,
 		ProvideConsensusEngine,
 		ProvideDAService,
 		ProvideDBManager,
@@ -56,6 +57,7 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideTelemetrySink,
 		ProvideTrustedSetup,
 		ProvideValidatorService,
+		ProvideVoluntaryExitPool,
 		// TODO Hacks
 		ProvideKVStoreService,
 		ProvideKVStoreKey,
@@ -25,10 +25,13 @@ import (
 
 	"cosmossdk.io/depinject"
 	sdklog "cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -38,8 +41,10 @@ type DepositServiceIn struct {
 	BeaconDepositContract *DepositContract
 	BlockBroker           *BlockBroker
 	ChainSpec             common.ChainSpec
+	Config                *config.Config
 	DepositStore          *DepositStore
 	EngineClient          *EngineClient
+	GenesisBroker         *GenesisBroker
 	Logger                log.AdvancedLogger[any, sdklog.Logger]
 	TelemetrySink         *metrics.TelemetrySink
 }
@@ -47,25 +52,39 @@ type DepositServiceIn struct {
 // ProvideDepositService provides the deposit service to the depinject
 // framework.
 func ProvideDepositService(in DepositServiceIn) (*DepositService, error) {
-	blkSub, err := in.BlockBroker.Subscribe()
+	blkSub, err := in.BlockBroker.SubscribeWithOptions(
+		broker.WithEventIDs(events.BeaconBlockFinalized),
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, errors.New("failed to subscribe to block feed")
 	}
 
+	genesisSub, err := in.GenesisBroker.SubscribeWithOptions(
+		broker.WithEventIDs(events.GenesisDataProcessRequest),
+	)
+	if err != nil {
+		in.Logger.Error("failed to subscribe to genesis feed", "err", err)
+		return nil, errors.New("failed to subscribe to genesis feed")
+	}
+
 	// Build the deposit service.
 	return deposit.NewService[
 		*BeaconBlockBody,
 		*BeaconBlock,
 		*BlockEvent,
 		*DepositStore,
+		*Eth1Data,
 		*ExecutionPayload,
+		*Genesis,
 	](
+		in.Config.Deposit,
 		in.Logger.With("service", "deposit"),
 		math.U64(in.ChainSpec.Eth1FollowDistance()),
 		in.TelemetrySink,
 		in.DepositStore,
 		in.BeaconDepositContract,
 		blkSub,
+		genesisSub,
 	), nil
 }
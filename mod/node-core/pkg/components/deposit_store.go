@@ -24,9 +24,11 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
@@ -69,7 +71,9 @@ type DepositPrunerInput struct {
 func ProvideDepositPruner(
 	in DepositPrunerInput,
 ) (DepositPruner, error) {
-	subCh, err := in.BlockBroker.Subscribe()
+	subCh, err := in.BlockBroker.SubscribeWithOptions(
+		broker.WithEventIDs(events.BeaconBlockFinalized),
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, err
@@ -89,6 +93,7 @@ func ProvideDepositPruner(
 			*BeaconBlock,
 			*BlockEvent,
 			*Deposit,
+			*Eth1Data,
 			*ExecutionPayload,
 			WithdrawalCredentials,
 		](in.ChainSpec),
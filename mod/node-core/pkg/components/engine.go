@@ -27,6 +27,7 @@ import (
 	sdklog "cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -49,22 +50,35 @@ type EngineClientInputs struct {
 // ProvideEngineClient creates a new EngineClient.
 func ProvideEngineClient(
 	in EngineClientInputs,
-) *EngineClient {
+) (*EngineClient, error) {
+	// Load the JWT secrets of the fallback execution clients.
+	cfg := in.Config.GetEngine()
+	jwtSecrets := []*jwt.Secret{in.JWTSecret}
+	for _, fallback := range cfg.RPCFallbacks {
+		jwtSecret, err := LoadJWTFromFile(fallback.JWTSecretPath)
+		if err != nil {
+			return nil, err
+		}
+		jwtSecrets = append(jwtSecrets, jwtSecret)
+	}
+
 	return client.New[
 		*ExecutionPayload,
 		*PayloadAttributes,
 	](
-		in.Config.GetEngine(),
+		cfg,
 		in.Logger.With("service", "engine.client"),
-		in.JWTSecret,
+		jwtSecrets,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
-	)
+	), nil
 }
 
 // EngineClientInputs is the input for the EngineClient.
 type ExecutionEngineInputs struct {
 	depinject.In
+	ChainSpec     common.ChainSpec
+	Config        *config.Config
 	EngineClient  *EngineClient
 	Logger        log.AdvancedLogger[any, sdklog.Logger]
 	StatusBroker  *StatusBroker
@@ -75,7 +89,46 @@ type ExecutionEngineInputs struct {
 // framework.
 func ProvideExecutionEngine(
 	in ExecutionEngineInputs,
-) *ExecutionEngine {
+) (*ExecutionEngine, error) {
+	cfg := in.Config.GetEngine()
+	if cfg.VerificationQuorum > uint64(len(cfg.RPCVerifiers))+1 {
+		return nil, errors.Newf(
+			"verification quorum %d exceeds the %d configured "+
+				"execution clients",
+			cfg.VerificationQuorum, len(cfg.RPCVerifiers)+1,
+		)
+	}
+
+	// Create an engine client for each of the verifiers, if the
+	// verification is enabled.
+	var verifiers []*EngineClient
+	if cfg.VerificationQuorum > 0 {
+		for _, endpoint := range cfg.RPCVerifiers {
+			jwtSecret, err := LoadJWTFromFile(endpoint.JWTSecretPath)
+			if err != nil {
+				return nil, err
+			}
+
+			verifierCfg := *cfg
+			verifierCfg.RPCDialURL = endpoint.RPCDialURL
+			verifierCfg.JWTSecretPath = endpoint.JWTSecretPath
+			verifierCfg.RPCFallbacks = nil
+			verifiers = append(verifiers, client.New[
+				*ExecutionPayload,
+				*PayloadAttributes,
+			](
+				&verifierCfg,
+				in.Logger.With(
+					"service", "engine.client",
+					"verifier", endpoint.RPCDialURL.String(),
+				),
+				[]*jwt.Secret{jwtSecret},
+				in.TelemetrySink,
+				new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
+			))
+		}
+	}
+
 	return engine.New[
 		*ExecutionPayload,
 		*PayloadAttributes,
@@ -83,8 +136,10 @@ func ProvideExecutionEngine(
 		engineprimitives.Withdrawals,
 	](
 		in.EngineClient,
+		verifiers,
+		cfg.VerificationQuorum,
 		in.Logger.With("service", "execution-engine"),
 		in.StatusBroker,
 		in.TelemetrySink,
-	)
+	), nil
 }
@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -46,13 +47,18 @@ type ABCIMiddlewareInput struct {
 func ProvideABCIMiddleware(
 	in ABCIMiddlewareInput,
 ) (*ABCIMiddleware, error) {
-	validatorUpdatesSub, err := in.ValidatorUpdateBroker.Subscribe()
+	// FinalizeBlock waits on the validator updates, so they must never be
+	// dropped.
+	validatorUpdatesSub, err := in.ValidatorUpdateBroker.SubscribeWithOptions(
+		broker.WithTimeout(0),
+	)
 	if err != nil {
 		return nil, err
 	}
 	return middleware.NewABCIMiddleware[
-		*AvailabilityStore, *BeaconBlock, *BlobSidecars,
-		*Deposit, *ExecutionPayload, *Genesis, *SlotData,
+		*AttestationData, *AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
+		*BlobSidecars, *Deposit, *ExecutionPayload, *Genesis, *SlashingInfo,
+		*SlotData,
 	](
 		in.ChainSpec,
 		in.Logger,
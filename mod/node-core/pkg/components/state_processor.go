@@ -43,6 +43,7 @@ func ProvideStateProcessor(
 	in StateProcessorInput,
 ) *StateProcessor {
 	return core.NewStateProcessor[
+		*AttestationData,
 		*BeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
@@ -55,8 +56,11 @@ func ProvideStateProcessor(
 		*Fork,
 		*ForkData,
 		*KVStore,
+		*SlashingInfo,
 		*Validator,
 		Validators,
+		*VoluntaryExit,
+		*WithdrawalRequest,
 		*Withdrawal,
 		engineprimitives.Withdrawals,
 		WithdrawalCredentials,
@@ -44,7 +44,7 @@ type Backend[
 	],
 	BeaconStateMarshallableT state.BeaconStateMarshallable[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, Eth1DataT,
-		ExecutionPayloadHeaderT, ForkT, ValidatorT,
+		ExecutionPayloadHeaderT, ForkT, PendingPartialWithdrawalT, ValidatorT,
 	],
 	BlobSidecarsT any,
 	BlockStoreT BlockStore[BeaconBlockT],
@@ -57,6 +57,7 @@ type Backend[
 		KVStoreT, BeaconBlockHeaderT, Eth1DataT,
 		ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT,
 	],
+	PendingPartialWithdrawalT any,
 	ValidatorT Validator[WithdrawalCredentialsT],
 	ValidatorsT ~[]ValidatorT,
 	WithdrawalT Withdrawal[WithdrawalT],
@@ -82,7 +83,7 @@ func NewBackend[
 	],
 	BeaconStateMarshallableT state.BeaconStateMarshallable[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, Eth1DataT,
-		ExecutionPayloadHeaderT, ForkT, ValidatorT,
+		ExecutionPayloadHeaderT, ForkT, PendingPartialWithdrawalT, ValidatorT,
 	],
 	BlobSidecarsT any,
 	BlockStoreT BlockStore[BeaconBlockT],
@@ -95,6 +96,7 @@ func NewBackend[
 		KVStoreT, BeaconBlockHeaderT, Eth1DataT,
 		ExecutionPayloadHeaderT, ForkT, ValidatorT, ValidatorsT,
 	],
+	PendingPartialWithdrawalT any,
 	ValidatorT Validator[WithdrawalCredentialsT],
 	ValidatorsT ~[]ValidatorT,
 	WithdrawalT Withdrawal[WithdrawalT],
@@ -109,13 +111,15 @@ func NewBackend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 	DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-	KVStoreT, ValidatorT, ValidatorsT, WithdrawalT, WithdrawalCredentialsT,
+	KVStoreT, PendingPartialWithdrawalT, ValidatorT, ValidatorsT, WithdrawalT,
+	WithdrawalCredentialsT,
 ] {
 	return &Backend[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 		DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-		KVStoreT, ValidatorT, ValidatorsT, WithdrawalT, WithdrawalCredentialsT,
+		KVStoreT, PendingPartialWithdrawalT, ValidatorT, ValidatorsT,
+		WithdrawalT, WithdrawalCredentialsT,
 	]{
 		cs:  cs,
 		as:  as,
@@ -128,7 +132,7 @@ func NewBackend[
 // AvailabilityStore returns the availability store struct initialized with a
 // given context.
 func (k Backend[
-	AvailabilityStoreT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	AvailabilityStoreT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) AvailabilityStore() AvailabilityStoreT {
 	return k.as
 }
@@ -139,7 +143,8 @@ func (k Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 	DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-	KVStoreT, ValidatorT, ValidatorsT, WithdrawalT, WithdrawalCredentialsT,
+	KVStoreT, PendingPartialWithdrawalT, ValidatorT, ValidatorsT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) StateFromContext(
 	ctx context.Context,
 ) BeaconStateT {
@@ -149,20 +154,20 @@ func (k Backend[
 
 // BeaconStore returns the beacon store struct.
 func (k Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, KVStoreT, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, KVStoreT, _, _, _, _, _,
 ]) BeaconStore() KVStoreT {
 	return k.kvs
 }
 
 func (k Backend[
-	_, _, _, _, _, _, _, BlockStoreT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, BlockStoreT, _, _, _, _, _, _, _, _, _, _, _,
 ]) BlockStore() BlockStoreT {
 	return k.bs
 }
 
 // DepositStore returns the deposit store struct initialized with a.
 func (k Backend[
-	_, _, _, _, _, _, _, _, _, DepositStoreT, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, DepositStoreT, _, _, _, _, _, _, _, _, _,
 ]) DepositStore() DepositStoreT {
 	return k.ds
 }
@@ -48,6 +48,7 @@ import (
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/consensus"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
@@ -64,6 +65,7 @@ import (
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pool"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -71,12 +73,15 @@ import (
 type (
 	// ABCIMiddleware is a type alias for the ABCIMiddleware.
 	ABCIMiddleware = middleware.ABCIMiddleware[
+		*AttestationData,
 		*AvailabilityStore,
 		*BeaconBlock,
+		*BeaconBlockBody,
 		*BlobSidecars,
 		*Deposit,
 		*ExecutionPayload,
 		*Genesis,
+		*SlashingInfo,
 		*SlotData,
 	]
 
@@ -106,6 +111,7 @@ type (
 		*ExecutionPayloadHeader,
 		*Fork,
 		*KVStore,
+		*PendingPartialWithdrawal,
 		*Validator,
 		Validators,
 		*Withdrawal,
@@ -132,6 +138,9 @@ type (
 		*BeaconBlockBody,
 	]
 
+	// BlobSidecar is a type alias for the blob sidecar.
+	BlobSidecar = datypes.BlobSidecar
+
 	// BlobSidecars is a type alias for the blob sidecars.
 	BlobSidecars = datypes.BlobSidecars
 
@@ -159,6 +168,9 @@ type (
 		*Withdrawal,
 	]
 
+	// ConsensusClient is a type alias for the consensus client.
+	ConsensusClient = consensus.Client
+
 	// ConsensusEngine is a type alias for the consensus engine.
 	ConsensusEngine = cometbft.ConsensusEngine[
 		*AttestationData,
@@ -182,6 +194,7 @@ type (
 	// DAService is a type alias for the DA service.
 	DAService = da.Service[
 		*AvailabilityStore,
+		*BeaconBlock,
 		*BeaconBlockBody,
 		*BlobSidecars,
 		*SidecarsBroker,
@@ -206,7 +219,9 @@ type (
 		*BeaconBlockBody,
 		*BlockEvent,
 		*Deposit,
+		*Eth1Data,
 		*ExecutionPayload,
+		*Genesis,
 		WithdrawalCredentials,
 	]
 
@@ -299,6 +314,7 @@ type (
 		*StorageBackend,
 		*Validator,
 		Validators,
+		*VoluntaryExit,
 		*Withdrawal,
 		WithdrawalCredentials,
 	]
@@ -321,6 +337,10 @@ type (
 	// PayloadID is a type alias for the payload ID.
 	PayloadID = engineprimitives.PayloadID
 
+	// PendingPartialWithdrawal is a type alias for the pending partial
+	// withdrawal.
+	PendingPartialWithdrawal = types.PendingPartialWithdrawal
+
 	// ReportingService is a type alias for the reporting service.
 	ReportingService = version.ReportingService
 
@@ -335,6 +355,7 @@ type (
 
 	// StateProcessor is the type alias for the state processor interface.
 	StateProcessor = core.StateProcessor[
+		*AttestationData,
 		*BeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
@@ -347,8 +368,11 @@ type (
 		*Fork,
 		*ForkData,
 		*KVStore,
+		*SlashingInfo,
 		*Validator,
 		Validators,
+		*VoluntaryExit,
+		*WithdrawalRequest,
 		*Withdrawal,
 		engineprimitives.Withdrawals,
 		WithdrawalCredentials,
@@ -370,6 +394,7 @@ type (
 		*ExecutionPayloadHeader,
 		*Fork,
 		*KVStore,
+		*PendingPartialWithdrawal,
 		*Validator,
 		Validators,
 		*Withdrawal,
@@ -397,16 +422,27 @@ type (
 		*ForkData,
 		*SlashingInfo,
 		*SlotData,
+		*VoluntaryExit,
 	]
 
 	// ValidatorUpdate is a type alias for the validator update.
 	ValidatorUpdate = appmodule.ValidatorUpdate
 
+	// VoluntaryExit is a type alias for the signed voluntary exit.
+	VoluntaryExit = types.SignedVoluntaryExit
+
+	// VoluntaryExitPool is a type alias for the voluntary exit pool.
+	VoluntaryExitPool = pool.VoluntaryExitPool[*VoluntaryExit]
+
 	// Withdrawal is a type alias for the engineprimitives withdrawal.
 	Withdrawal = engineprimitives.Withdrawal
 
 	// WithdrawalCredentials is a type alias for the withdrawal credentials.
 	WithdrawalCredentials = types.WithdrawalCredentials
+
+	// WithdrawalRequest is a type alias for the execution layer withdrawal
+	// request.
+	WithdrawalRequest = types.WithdrawalRequest
 )
 
 /* -------------------------------------------------------------------------- */
@@ -479,7 +515,8 @@ type (
 type (
 	// BeaconAPIHandler is a type alias for the beacon handler.
 	BeaconAPIHandler = beaconapi.Handler[
-		*BeaconBlockHeader, NodeAPIContext, *Fork, *Validator,
+		*BeaconBlock, *BeaconBlockHeader, *BlobSidecar, *BlobSidecars,
+		NodeAPIContext, *Fork, *Validator, *VoluntaryExit,
 	]
 
 	// BuilderAPIHandler is a type alias for the builder handler.
@@ -489,10 +526,15 @@ type (
 	ConfigAPIHandler = configapi.Handler[NodeAPIContext]
 
 	// DebugAPIHandler is a type alias for the debug handler.
-	DebugAPIHandler = debugapi.Handler[NodeAPIContext]
+	DebugAPIHandler = debugapi.Handler[
+		NodeAPIContext, *BeaconState, *BeaconStateMarshallable,
+	]
 
 	// EventsAPIHandler is a type alias for the events handler.
-	EventsAPIHandler = eventsapi.Handler[NodeAPIContext]
+	EventsAPIHandler = eventsapi.Handler[
+		*BeaconBlock, *BeaconBlockHeader, *BlobSidecar, *BlobSidecars,
+		NodeAPIContext,
+	]
 
 	// NodeAPIHandler is a type alias for the node handler.
 	NodeAPIHandler = nodeapi.Handler[NodeAPIContext]
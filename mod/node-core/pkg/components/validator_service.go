@@ -34,19 +34,21 @@ import (
 // ValidatorServiceInput is the input for the validator service provider.
 type ValidatorServiceInput struct {
 	depinject.In
-	BeaconBlockFeed *BlockBroker
-	BlobProcessor   *BlobProcessor
-	Cfg             *config.Config
-	ChainSpec       common.ChainSpec
-	LocalBuilder    *LocalBuilder
-	Logger          log.AdvancedLogger[any, sdklog.Logger]
-	StateProcessor  *StateProcessor
-	StorageBackend  *StorageBackend
-	Signer          crypto.BLSSigner
-	SidecarsFeed    *SidecarsBroker
-	SidecarFactory  *SidecarFactory
-	SlotBroker      *SlotBroker
-	TelemetrySink   *metrics.TelemetrySink
+	BeaconBlockFeed   *BlockBroker
+	BlobProcessor     *BlobProcessor
+	Cfg               *config.Config
+	ChainSpec         common.ChainSpec
+	DepositService    *DepositService
+	LocalBuilder      *LocalBuilder
+	Logger            log.AdvancedLogger[any, sdklog.Logger]
+	StateProcessor    *StateProcessor
+	StorageBackend    *StorageBackend
+	Signer            crypto.BLSSigner
+	SidecarsFeed      *SidecarsBroker
+	SidecarFactory    *SidecarFactory
+	SlotBroker        *SlotBroker
+	TelemetrySink     *metrics.TelemetrySink
+	VoluntaryExitPool *VoluntaryExitPool
 }
 
 // ProvideValidatorService is a depinject provider for the validator service.
@@ -73,14 +75,17 @@ func ProvideValidatorService(
 		*ForkData,
 		*SlashingInfo,
 		*SlotData,
+		*VoluntaryExit,
 	](
 		&in.Cfg.Validator,
 		in.Logger.With("service", "validator"),
 		in.ChainSpec,
 		in.StorageBackend,
+		in.DepositService,
 		in.StateProcessor,
 		in.Signer,
 		in.SidecarFactory,
+		in.VoluntaryExitPool,
 		in.LocalBuilder,
 		[]validator.PayloadBuilder[*BeaconState, *ExecutionPayload]{
 			in.LocalBuilder,
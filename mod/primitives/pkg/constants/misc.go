@@ -31,4 +31,19 @@ const (
 	GenesisEpoch uint64 = 0
 	// FarFutureEpoch represents a far future epoch value.
 	FarFutureEpoch = ^uint64(0)
+	// DepositContractTreeDepth is the depth of the deposit contract Merkle
+	// tree.
+	DepositContractTreeDepth uint8 = 32
+	// DepositProofLength is the length of a deposit Merkle branch, which
+	// includes the mixed in deposit count.
+	DepositProofLength = DepositContractTreeDepth + 1
+	// HysteresisQuotient is the divisor of the effective balance increment
+	// that sets the granularity of the effective balance hysteresis.
+	HysteresisQuotient uint64 = 4
+	// HysteresisDownwardMultiplier is the number of hysteresis increments a
+	// balance may fall below the effective balance before it is updated.
+	HysteresisDownwardMultiplier uint64 = 1
+	// HysteresisUpwardMultiplier is the number of hysteresis increments a
+	// balance may rise above the effective balance before it is updated.
+	HysteresisUpwardMultiplier uint64 = 5
 )
@@ -34,10 +34,46 @@ const (
 	// MaxDepositsPerBlock is the maximum number of deposits per block.
 	MaxDepositsPerBlock uint64 = 16
 
+	// MaxAttestationsPerBlock is the maximum number of attestations per
+	// block, one for each validator that voted on the parent block.
+	MaxAttestationsPerBlock uint64 = 8192
+
+	// MaxVoluntaryExitsPerBlock is the maximum number of voluntary exits per
+	// block.
+	MaxVoluntaryExitsPerBlock uint64 = 16
+
+	// MaxSlashingInfoPerBlock is the maximum number of slashing info entries
+	// per block, bounded by the evidence CometBFT may include in a block.
+	MaxSlashingInfoPerBlock uint64 = 256
+
 	// MaxWithdrawalsPerPayload is the maximum number of withdrawals in a
 	// execution payload.
 	MaxWithdrawalsPerPayload uint64 = 16
 
+	// MaxDepositRequestsPerPayload is the maximum number of deposit requests
+	// in an execution payload, as per EIP-6110.
+	MaxDepositRequestsPerPayload uint64 = 8192
+
+	// MaxWithdrawalRequestsPerPayload is the maximum number of withdrawal
+	// requests in an execution payload, as per EIP-7002.
+	MaxWithdrawalRequestsPerPayload uint64 = 16
+
+	// MaxConsolidationRequestsPerPayload is the maximum number of
+	// consolidation requests in an execution payload, as per EIP-7251.
+	MaxConsolidationRequestsPerPayload uint64 = 2
+
+	// PendingPartialWithdrawalsLimit is the maximum number of pending partial
+	// withdrawals in the queue, as per EIP-7002.
+	PendingPartialWithdrawalsLimit uint64 = 134217728
+
+	// MaxPendingPartialsPerWithdrawalsSweep is the maximum number of pending
+	// partial withdrawals processed in a single withdrawals sweep.
+	MaxPendingPartialsPerWithdrawalsSweep uint64 = 8
+
+	// FullExitRequestAmount is the amount of a withdrawal request that
+	// requests the full exit of the validator, as per EIP-7002.
+	FullExitRequestAmount uint64 = 0
+
 	// MaxBytesPerTx is the maximum number of bytes per transaction.
 	MaxBytesPerTx uint64 = 1073741824
 )
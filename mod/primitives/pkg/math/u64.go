@@ -21,6 +21,7 @@
 package math
 
 import (
+	stdmath "math"
 	"math/big"
 	"strconv"
 
@@ -134,6 +135,23 @@ func (u U64) ILog2Floor() uint8 {
 	return log.ILog2Floor(u)
 }
 
+// ISqrt returns the largest integer x such that x * x <= u.
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#integer_squareroot
+//
+//nolint:lll // From Ethereum 2.0 spec.
+func (u U64) ISqrt() U64 {
+	if u == U64(stdmath.MaxUint64) {
+		return U64(stdmath.MaxUint32)
+	}
+	x, y := u, (u+1)/2
+	for y < x {
+		x = y
+		y = (x + u/x) / 2
+	}
+	return x
+}
+
 // ---------------------------- Gwei Methods ----------------------------
 
 // GweiFromWei returns the value of Wei in Gwei.
@@ -255,6 +255,27 @@ func TestU64_ILog2Floor(t *testing.T) {
 	}
 }
 
+func TestU64_ISqrt(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    math.U64
+		expected math.U64
+	}{
+		{name: "zero", value: 0, expected: 0},
+		{name: "one", value: 1, expected: 1},
+		{name: "perfect square", value: 1024, expected: 32},
+		{name: "not a perfect square", value: 1023, expected: 31},
+		{name: "large value", value: 1 << 62, expected: 1 << 31},
+		{name: "max uint64", value: 1<<64 - 1, expected: 1<<32 - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.value.ISqrt())
+		})
+	}
+}
+
 func TestU64_PrevPowerOfTwo(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -149,3 +149,25 @@ func TestToUint32_CustomType(t *testing.T) {
 	result := version.ToUint32(input)
 	require.Equal(t, expected, result)
 }
+
+func TestName(t *testing.T) {
+	tests := []struct {
+		input    uint32
+		expected string
+	}{
+		{input: version.Phase0, expected: "phase0"},
+		{input: version.Altair, expected: "altair"},
+		{input: version.Bellatrix, expected: "bellatrix"},
+		{input: version.Capella, expected: "capella"},
+		{input: version.Deneb, expected: "deneb"},
+		{input: version.DenebPlus, expected: "deneb_plus"},
+		{input: version.Electra, expected: "electra"},
+		{input: 42, expected: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			require.Equal(t, tt.expected, version.Name(tt.input))
+		})
+	}
+}
@@ -461,6 +461,10 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 
 	app.finalizeBlockState = nil
 
+	// Take a state sync snapshot in the background if this height is on the
+	// snapshot interval.
+	app.snapshotManager.SnapshotIfApplicable(header.Height)
+
 	return resp, nil
 }
 
@@ -622,6 +626,18 @@ func (app *BaseApp) GetBlockRetentionHeight(commitHeight int64) int64 {
 		retentionHeight = commitHeight - cp.Evidence.MaxAgeNumBlocks
 	}
 
+	// Blocks since the oldest retained snapshot must be kept around for nodes
+	// that are still restoring it.
+	if app.snapshotManager != nil {
+		snapshotRetentionHeights := app.snapshotManager.
+			GetSnapshotBlockRetentionHeights()
+		if snapshotRetentionHeights > 0 {
+			retentionHeight = minNonZero(
+				retentionHeight, commitHeight-snapshotRetentionHeights,
+			)
+		}
+	}
+
 	//#nosec:G701 // bet.
 	v := commitHeight - int64(app.minRetainBlocks)
 	retentionHeight = minNonZero(retentionHeight, v)
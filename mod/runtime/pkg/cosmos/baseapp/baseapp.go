@@ -28,6 +28,8 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -66,6 +68,10 @@ type BaseApp struct {
 	processProposal sdk.ProcessProposalHandler                                                // ABCI ProcessProposal handler
 	prepareProposal sdk.PrepareProposalHandler                                                // ABCI PrepareProposal handler
 
+	// queryRoutes maps the first segment of an ABCI query path to the
+	// handler serving it.
+	queryRoutes map[string]queryRoute
+
 	// volatile states:
 	//
 	// - prepareProposalState: Used for PrepareProposal, which is set based on
@@ -93,6 +99,10 @@ type BaseApp struct {
 	// application parameter store.
 	paramStore ParamStore
 
+	// snapshotManager takes, serves and restores the state sync snapshots of
+	// the multistore. It is nil if no snapshot store has been configured.
+	snapshotManager *snapshots.Manager
+
 	// initialHeight is the initial height at which we start the BaseApp
 	initialHeight int64
 
@@ -252,6 +262,23 @@ func (app *BaseApp) setMinRetainBlocks(minRetainBlocks uint64) {
 	app.minRetainBlocks = minRetainBlocks
 }
 
+func (app *BaseApp) setSnapshot(
+	snapshotStore *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) {
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+
+	// Heights at which a snapshot is taken must not be pruned before the
+	// snapshot has been written.
+	app.cms.SetSnapshotInterval(opts.Interval)
+	app.snapshotManager = snapshots.NewManager(
+		snapshotStore, opts, app.cms, nil, app.logger,
+	)
+}
+
 func (app *BaseApp) setInterBlockCache(
 	cache storetypes.MultiStorePersistentCache,
 ) {
@@ -342,6 +369,14 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	// Close the snapshot metadata db opened alongside the snapshot store.
+	if app.snapshotManager != nil {
+		app.logger.Info("Closing snapshots/metadata.db")
+		if err := app.snapshotManager.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -26,6 +26,8 @@ import (
 	"fmt"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -47,6 +49,15 @@ func SetMinRetainBlocks(minRetainBlocks uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setMinRetainBlocks(minRetainBlocks) }
 }
 
+// SetSnapshot returns a BaseApp option function that sets the snapshot store
+// and the interval and retention at which state sync snapshots are taken.
+func SetSnapshot(
+	snapshotStore *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setSnapshot(snapshotStore, opts) }
+}
+
 // SetIAVLCacheSize provides a BaseApp option function that sets the size of
 // IAVL cache.
 func SetIAVLCacheSize(size int) func(*BaseApp) {
@@ -126,3 +137,19 @@ func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 func (app *BaseApp) SetPrepareProposal(handler sdk.PrepareProposalHandler) {
 	app.prepareProposal = handler
 }
+
+// SetQueryHandler registers the handler serving the ABCI queries whose path
+// starts with the given route, reading from the store mounted under storeKey.
+func (app *BaseApp) SetQueryHandler(
+	route string,
+	storeKey storetypes.StoreKey,
+	handler QueryHandler,
+) {
+	if app.queryRoutes == nil {
+		app.queryRoutes = make(map[string]queryRoute)
+	}
+	app.queryRoutes[route] = queryRoute{
+		storeName: storeKey.Name(),
+		handler:   handler,
+	}
+}
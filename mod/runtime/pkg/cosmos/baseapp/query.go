@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package baseapp
+
+import (
+	"context"
+	"strings"
+
+	cosmoserrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	errorsmod "github.com/berachain/beacon-kit/mod/errors"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// QueryHandler resolves the path segments of an ABCI query following its
+// route to the key it reads from the store, given a context on the multistore
+// at the queried height.
+type QueryHandler func(ctx context.Context, path []string) ([]byte, error)
+
+// queryRoute is a QueryHandler along with the name of the store it reads.
+type queryRoute struct {
+	storeName string
+	handler   QueryHandler
+}
+
+// Query implements the ABCI interface. The first segment of the query path
+// selects the handler, which resolves the rest of the path to a key in its
+// store. The key is then read from the multistore at the queried height,
+// along with a proof against the app hash if one is requested.
+func (app *BaseApp) Query(
+	_ context.Context,
+	req *abci.QueryRequest,
+) (*abci.QueryResponse, error) {
+	// when a client did not provide a query height, manually inject the latest
+	if req.Height == 0 {
+		req.Height = app.LastBlockHeight()
+	}
+
+	path := splitABCIQueryPath(req.Path)
+	if len(path) == 0 {
+		return queryResult(
+			errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "no query path provided"),
+		), nil
+	}
+
+	route, ok := app.queryRoutes[path[0]]
+	if !ok {
+		return queryResult(
+			errorsmod.Wrapf(
+				sdkerrors.ErrUnknownRequest, "unknown query path: %s", req.Path,
+			),
+		), nil
+	}
+
+	ctx, err := app.CreateQueryContext(req.Height, req.Prove)
+	if err != nil {
+		return queryResult(err), nil
+	}
+
+	key, err := route.handler(ctx, path[1:])
+	if err != nil {
+		return queryResult(err), nil
+	}
+
+	queryable, ok := app.cms.(storetypes.Queryable)
+	if !ok {
+		return queryResult(
+			errorsmod.Wrap(
+				sdkerrors.ErrInvalidRequest, "multistore does not support queries",
+			),
+		), nil
+	}
+
+	resp, err := queryable.Query(&storetypes.RequestQuery{
+		Data:   key,
+		Path:   "/" + route.storeName + "/key",
+		Height: req.Height,
+		Prove:  req.Prove,
+	})
+	if err != nil {
+		return queryResult(err), nil
+	}
+
+	return &abci.QueryResponse{
+		Code:      resp.Code,
+		Log:       resp.Log,
+		Info:      resp.Info,
+		Index:     resp.Index,
+		Key:       resp.Key,
+		Value:     resp.Value,
+		ProofOps:  resp.ProofOps,
+		Height:    resp.Height,
+		Codespace: resp.Codespace,
+	}, nil
+}
+
+// queryResult returns a QueryResponse carrying the ABCI code and log of the
+// given error.
+func queryResult(err error) *abci.QueryResponse {
+	space, code, log := cosmoserrors.ABCIInfo(err, false)
+	return &abci.QueryResponse{
+		Codespace: space,
+		Code:      code,
+		Log:       log,
+	}
+}
+
+// splitABCIQueryPath splits a string path using the delimiter '/'.
+//
+// e.g. "this/is/funny" becomes []string{"this", "is", "funny"}
+func splitABCIQueryPath(requestPath string) []string {
+	path := strings.Split(strings.Trim(requestPath, "/"), "/")
+	if len(path) == 1 && path[0] == "" {
+		return nil
+	}
+	return path
+}
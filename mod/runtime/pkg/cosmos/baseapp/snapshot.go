@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package baseapp
+
+import (
+	"errors"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+)
+
+// ListSnapshots implements the ABCI interface. It delegates to
+// app.snapshotManager if set.
+func (app *BaseApp) ListSnapshots(
+	_ *abci.ListSnapshotsRequest,
+) (*abci.ListSnapshotsResponse, error) {
+	resp := &abci.ListSnapshotsResponse{Snapshots: []*abci.Snapshot{}}
+	if app.snapshotManager == nil {
+		return resp, nil
+	}
+
+	snapshotList, err := app.snapshotManager.List()
+	if err != nil {
+		app.logger.Error("failed to list snapshots", "err", err)
+		return nil, err
+	}
+
+	for _, snapshot := range snapshotList {
+		abciSnapshot, err := snapshot.ToABCI()
+		if err != nil {
+			app.logger.Error("failed to convert ABCI snapshots", "err", err)
+			return nil, err
+		}
+		resp.Snapshots = append(resp.Snapshots, &abciSnapshot)
+	}
+
+	return resp, nil
+}
+
+// LoadSnapshotChunk implements the ABCI interface. It delegates to
+// app.snapshotManager if set.
+func (app *BaseApp) LoadSnapshotChunk(
+	req *abci.LoadSnapshotChunkRequest,
+) (*abci.LoadSnapshotChunkResponse, error) {
+	if app.snapshotManager == nil {
+		return &abci.LoadSnapshotChunkResponse{}, nil
+	}
+
+	chunk, err := app.snapshotManager.LoadChunk(
+		req.Height, req.Format, req.Chunk,
+	)
+	if err != nil {
+		app.logger.Error(
+			"failed to load snapshot chunk",
+			"height", req.Height,
+			"format", req.Format,
+			"chunk", req.Chunk,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	return &abci.LoadSnapshotChunkResponse{Chunk: chunk}, nil
+}
+
+// OfferSnapshot implements the ABCI interface. It delegates to
+// app.snapshotManager if set.
+func (app *BaseApp) OfferSnapshot(
+	req *abci.OfferSnapshotRequest,
+) (*abci.OfferSnapshotResponse, error) {
+	if app.snapshotManager == nil {
+		app.logger.Error("snapshot manager not configured")
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_ABORT,
+		}, nil
+	}
+
+	if req.Snapshot == nil {
+		app.logger.Error("received nil snapshot")
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	}
+
+	snapshot, err := snapshottypes.SnapshotFromABCI(req.Snapshot)
+	if err != nil {
+		app.logger.Error("failed to decode snapshot metadata", "err", err)
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	}
+
+	err = app.snapshotManager.Restore(snapshot)
+	switch {
+	case err == nil:
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_ACCEPT,
+		}, nil
+
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT_FORMAT,
+		}, nil
+
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		app.logger.Error(
+			"rejecting invalid snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"err", err,
+		)
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+
+	default:
+		app.logger.Error(
+			"failed to restore snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"err", err,
+		)
+
+		// Resetting the IAVL stores to retry with a different snapshot is not
+		// supported, so we ask CometBFT to abort the restoration altogether.
+		return &abci.OfferSnapshotResponse{
+			Result: abci.OFFER_SNAPSHOT_RESULT_ABORT,
+		}, nil
+	}
+}
+
+// ApplySnapshotChunk implements the ABCI interface. It delegates to
+// app.snapshotManager if set. Every chunk is checked against the hash in the
+// snapshot metadata before it is applied, and chunks that do not match are
+// refetched from a different peer.
+func (app *BaseApp) ApplySnapshotChunk(
+	req *abci.ApplySnapshotChunkRequest,
+) (*abci.ApplySnapshotChunkResponse, error) {
+	if app.snapshotManager == nil {
+		app.logger.Error("snapshot manager not configured")
+		return &abci.ApplySnapshotChunkResponse{
+			Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		}, nil
+	}
+
+	_, err := app.snapshotManager.RestoreChunk(req.Chunk)
+	switch {
+	case err == nil:
+		return &abci.ApplySnapshotChunkResponse{
+			Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT,
+		}, nil
+
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		app.logger.Error(
+			"chunk checksum mismatch; rejecting sender and requesting refetch",
+			"chunk", req.Index,
+			"sender", req.Sender,
+			"err", err,
+		)
+		return &abci.ApplySnapshotChunkResponse{
+			Result:        abci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}, nil
+
+	default:
+		app.logger.Error("failed to restore snapshot", "err", err)
+		return &abci.ApplySnapshotChunkResponse{
+			Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		}, nil
+	}
+}
+
+// SnapshotManager returns the snapshot manager, which is nil if no snapshot
+// store has been configured.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager {
+	return app.snapshotManager
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package baseapp
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+// testExtension is a snapshot extension holding a list of items.
+type testExtension struct {
+	items [][]byte
+}
+
+func (e *testExtension) SnapshotName() string { return "test" }
+
+func (e *testExtension) SnapshotFormat() uint32 { return 1 }
+
+func (e *testExtension) SupportedFormats() []uint32 { return []uint32{1} }
+
+func (e *testExtension) SnapshotExtension(
+	_ uint64,
+	write snapshottypes.ExtensionPayloadWriter,
+) error {
+	for _, item := range e.items {
+		if err := write(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *testExtension) RestoreExtension(
+	_ uint64,
+	_ uint32,
+	read snapshottypes.ExtensionPayloadReader,
+) error {
+	for {
+		item, err := read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		e.items = append(e.items, item)
+	}
+}
+
+// newSnapshotApp returns a BaseApp with a single store mounted and snapshots
+// stored in the given directory.
+func newSnapshotApp(
+	t *testing.T,
+	key *storetypes.KVStoreKey,
+	ext *testExtension,
+) *BaseApp {
+	t.Helper()
+	snapshotStore, err := snapshots.NewStore(
+		dbm.NewMemDB(), filepath.Join(t.TempDir(), "snapshots"),
+	)
+	require.NoError(t, err)
+
+	app := NewBaseApp(
+		"test", log.NewNopLogger(), dbm.NewMemDB(),
+		SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(1, 1)),
+	)
+	app.MountStore(key, storetypes.StoreTypeIAVL)
+	require.NoError(t, app.LoadLatestVersion())
+	require.NoError(t, app.SnapshotManager().RegisterExtensions(ext))
+	t.Cleanup(func() { require.NoError(t, app.Close()) })
+	return app
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	key := storetypes.NewKVStoreKey("beacon")
+	source := newSnapshotApp(t, key, &testExtension{
+		items: [][]byte{[]byte("deposit-0"), []byte("deposit-1")},
+	})
+
+	// Commit some state and take a snapshot of it.
+	kvs := source.CommitMultiStore().GetKVStore(key)
+	for i := range byte(100) {
+		kvs.Set([]byte{i}, []byte{i, i})
+	}
+	commitID := source.CommitMultiStore().Commit()
+	_, err := source.SnapshotManager().Create(uint64(commitID.Version))
+	require.NoError(t, err)
+
+	list, err := source.ListSnapshots(&abci.ListSnapshotsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Snapshots, 1)
+	snapshot := list.Snapshots[0]
+	require.Equal(t, uint64(commitID.Version), snapshot.Height)
+
+	// Restore the snapshot on a fresh app, chunk by chunk.
+	ext := &testExtension{}
+	target := newSnapshotApp(t, key, ext)
+	offer, err := target.OfferSnapshot(&abci.OfferSnapshotRequest{
+		Snapshot: snapshot,
+		AppHash:  commitID.Hash,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.OFFER_SNAPSHOT_RESULT_ACCEPT, offer.Result)
+
+	for i := range snapshot.Chunks {
+		chunk, err := source.LoadSnapshotChunk(&abci.LoadSnapshotChunkRequest{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  i,
+		})
+		require.NoError(t, err)
+		applied, err := target.ApplySnapshotChunk(
+			&abci.ApplySnapshotChunkRequest{Index: i, Chunk: chunk.Chunk},
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t, abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT, applied.Result,
+		)
+	}
+
+	require.Equal(t, commitID, target.LastCommitID())
+	restored := target.CommitMultiStore().GetKVStore(key)
+	for i := range byte(100) {
+		require.Equal(t, []byte{i, i}, restored.Get([]byte{i}))
+	}
+	require.Equal(
+		t, [][]byte{[]byte("deposit-0"), []byte("deposit-1")}, ext.items,
+	)
+}
+
+func TestApplySnapshotChunk_HashMismatch(t *testing.T) {
+	key := storetypes.NewKVStoreKey("beacon")
+	source := newSnapshotApp(t, key, &testExtension{})
+	source.CommitMultiStore().GetKVStore(key).Set([]byte("k"), []byte("v"))
+	commitID := source.CommitMultiStore().Commit()
+	_, err := source.SnapshotManager().Create(uint64(commitID.Version))
+	require.NoError(t, err)
+	list, err := source.ListSnapshots(&abci.ListSnapshotsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Snapshots, 1)
+
+	target := newSnapshotApp(t, key, &testExtension{})
+	offer, err := target.OfferSnapshot(&abci.OfferSnapshotRequest{
+		Snapshot: list.Snapshots[0],
+		AppHash:  commitID.Hash,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.OFFER_SNAPSHOT_RESULT_ACCEPT, offer.Result)
+
+	// A corrupted chunk is refetched from a different peer.
+	applied, err := target.ApplySnapshotChunk(&abci.ApplySnapshotChunkRequest{
+		Index:  0,
+		Chunk:  []byte("corrupted"),
+		Sender: "peer",
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY, applied.Result)
+	require.Equal(t, []uint32{0}, applied.RefetchChunks)
+	require.Equal(t, []string{"peer"}, applied.RejectSenders)
+}
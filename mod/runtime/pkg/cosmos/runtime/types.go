@@ -45,6 +45,9 @@ type Middleware interface {
 	ProcessProposal(
 		ctx context.Context,
 		req proto.Message,
+		slotData *types.SlotData[
+			*ctypes.AttestationData,
+			*ctypes.SlashingInfo],
 	) (proto.Message, error)
 
 	FinalizeBlock(
@@ -26,10 +26,12 @@ import (
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -42,7 +44,7 @@ import (
 
 // InitGenesis is called by the base app to initialize the state of the.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, GenesisT, _,
+	_, _, _, _, _, _, _, GenesisT, _, _,
 ]) InitGenesis(
 	ctx context.Context,
 	bz []byte,
@@ -78,7 +80,7 @@ func (h *ABCIMiddleware[
 // waitForGenesisData waits for the genesis data to be processed and returns
 // the validator updates.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, GenesisT, _,
+	_, _, _, _, _, _, _, GenesisT, _, _,
 ]) waitForGenesisData(ctx context.Context) (
 	transition.ValidatorUpdates, error) {
 	select {
@@ -101,7 +103,7 @@ func (h *ABCIMiddleware[
 
 // prepareProposal is the internal handler for preparing proposals.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, SlotDataT,
+	_, _, _, _, _, _, _, _, _, SlotDataT,
 ]) PrepareProposal(
 	ctx context.Context,
 	slotData SlotDataT,
@@ -141,7 +143,7 @@ func (h *ABCIMiddleware[
 
 // waitForSidecars waits for the sidecars to be built and returns them.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _,
 ]) waitForSidecars(ctx context.Context) ([]byte, error) {
 	select {
 	case <-ctx.Done():
@@ -156,7 +158,7 @@ func (h *ABCIMiddleware[
 
 // waitforBeaconBlk waits for the beacon block to be built and returns it.
 func (h *ABCIMiddleware[
-	_, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _,
 ]) waitforBeaconBlk(ctx context.Context) ([]byte, error) {
 	select {
 	case <-ctx.Done():
@@ -178,11 +180,14 @@ func (h *ABCIMiddleware[
 
 // ProcessProposal processes the proposal for the ABCI middleware.
 // It handles both the beacon block and blob sidecars concurrently.
+// The slot data holds what CometBFT reported for the proposed slot, which
+// the beacon block must agree with.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, _, BeaconBlockT, _, BlobSidecarsT, _, _, _, _, SlotDataT,
 ]) ProcessProposal(
 	ctx context.Context,
 	req proto.Message,
+	slotData SlotDataT,
 ) (proto.Message, error) {
 	var (
 		blk       BeaconBlockT
@@ -203,6 +208,11 @@ func (h *ABCIMiddleware[
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
 	}
 
+	// Verify the beacon block against the slot data before processing it.
+	if err = h.verifySlotData(blk, slotData); err != nil {
+		return h.createProcessProposalResponse(err)
+	}
+
 	// Begin processing the beacon block.
 	g.Go(func() error {
 		return h.verifyBeaconBlock(ctx, blk)
@@ -223,11 +233,54 @@ func (h *ABCIMiddleware[
 	return h.createProcessProposalResponse(g.Wait())
 }
 
+// verifySlotData verifies that the attestations and slashing info of the
+// beacon block match the votes and misbehaviors reported by CometBFT, as the
+// state transition rewards and slashes validators based on them.
+func (h *ABCIMiddleware[
+	_, _, BeaconBlockT, _, _, _, _, _, _, SlotDataT,
+]) verifySlotData(
+	blk BeaconBlockT,
+	slotData SlotDataT,
+) error {
+	// The attestations and slashing info are only part of the block body
+	// from Electra onwards.
+	if blk.IsNil() ||
+		h.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()) < version.Electra {
+		return nil
+	}
+
+	if !equalRoots(
+		blk.GetBody().GetAttestations(), slotData.GetAttestationData(),
+	) {
+		return ErrAttestationsMismatch
+	}
+	if !equalRoots(
+		blk.GetBody().GetSlashingInfo(), slotData.GetSlashingInfo(),
+	) {
+		return ErrSlashingInfoMismatch
+	}
+	return nil
+}
+
+// equalRoots returns true if both lists hold objects with the same hash tree
+// roots in the same order.
+func equalRoots[T constraints.SSZRootable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].HashTreeRoot() != b[i].HashTreeRoot() {
+			return false
+		}
+	}
+	return true
+}
+
 // verifyBeaconBlock handles the processing of the beacon block.
 // It requests the block, publishes a received event, and waits for
 // verification.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, _, BeaconBlockT, _, BlobSidecarsT, _, _, _, _, _,
 ]) verifyBeaconBlock(
 	ctx context.Context,
 	blk BeaconBlockT,
@@ -258,7 +311,7 @@ func (h *ABCIMiddleware[
 // It requests the sidecars, publishes a received event, and waits for
 // processing.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, _, BeaconBlockT, _, BlobSidecarsT, _, _, _, _, _,
 ]) verifyBlobSidecars(
 	ctx context.Context,
 	sidecars BlobSidecarsT,
@@ -288,7 +341,7 @@ func (h *ABCIMiddleware[
 // createResponse generates the appropriate ProcessProposalResponse based on the
 // error.
 func (*ABCIMiddleware[
-	_, BeaconBlockT, _, BlobSidecarsT, _, _, _,
+	_, _, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _,
 ]) createProcessProposalResponse(
 	err error,
 ) (proto.Message, error) {
@@ -306,7 +359,7 @@ func (*ABCIMiddleware[
 
 // EndBlock returns the validator set updates from the beacon state.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, _, BeaconBlockT, _, BlobSidecarsT, _, _, _, _, _,
 ]) FinalizeBlock(
 	ctx context.Context, req proto.Message,
 ) (transition.ValidatorUpdates, error) {
@@ -341,7 +394,7 @@ func (h *ABCIMiddleware[
 
 // processSidecars publishes the sidecars and waits for a response.
 func (h *ABCIMiddleware[
-	_, _, BlobSidecarsT, _, _, _, _,
+	_, _, _, _, BlobSidecarsT, _, _, _, _, _,
 ]) processSidecars(ctx context.Context, blobs BlobSidecarsT) error {
 	// Publish the sidecars.
 	if err := h.sidecarsBroker.Publish(ctx, asynctypes.NewEvent(
@@ -367,7 +420,7 @@ func (h *ABCIMiddleware[
 
 // processBeaconBlock processes the beacon block and returns validator updates.
 func (h *ABCIMiddleware[
-	_, BeaconBlockT, _, _, _, _, _,
+	_, _, BeaconBlockT, _, _, _, _, _, _, _,
 ]) processBeaconBlock(
 	ctx context.Context, blk BeaconBlockT,
 ) (transition.ValidatorUpdates, error) {
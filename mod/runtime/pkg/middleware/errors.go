@@ -35,4 +35,10 @@ var (
 	ErrInvalidFinalizeBlockRequestType = errors.New(
 		"invalid pre block request type",
 	)
+	// ErrAttestationsMismatch is returned when the attestations of a beacon
+	// block do not match the votes reported by CometBFT.
+	ErrAttestationsMismatch = errors.New("attestations mismatch")
+	// ErrSlashingInfoMismatch is returned when the slashing info of a
+	// beacon block does not match the misbehaviors reported by CometBFT.
+	ErrSlashingInfoMismatch = errors.New("slashing info mismatch")
 )
@@ -38,8 +38,10 @@ import (
 
 // ABCIMiddleware is a middleware between ABCI and the validator logic.
 type ABCIMiddleware[
+	AttestationDataT constraints.SSZRootable,
 	AvailabilityStoreT any,
-	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[AttestationDataT, SlashingInfoT],
 	BlobSidecarsT interface {
 		constraints.SSZMarshallable
 		Empty() BlobSidecarsT
@@ -47,7 +49,8 @@ type ABCIMiddleware[
 	DepositT,
 	ExecutionPayloadT any,
 	GenesisT json.Unmarshaler,
-	SlotDataT any,
+	SlashingInfoT constraints.SSZRootable,
+	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
 ] struct {
 	// chainSpec is the chain specification.
 	chainSpec common.ChainSpec
@@ -95,8 +98,10 @@ type ABCIMiddleware[
 
 // NewABCIMiddleware creates a new instance of the Handler struct.
 func NewABCIMiddleware[
+	AttestationDataT constraints.SSZRootable,
 	AvailabilityStoreT any,
-	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[AttestationDataT, SlashingInfoT],
 	BlobSidecarsT interface {
 		constraints.SSZMarshallable
 		Empty() BlobSidecarsT
@@ -104,7 +109,8 @@ func NewABCIMiddleware[
 	DepositT,
 	ExecutionPayloadT any,
 	GenesisT json.Unmarshaler,
-	SlotDataT any,
+	SlashingInfoT constraints.SSZRootable,
+	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
 ](
 	chainSpec common.ChainSpec,
 	logger log.Logger[any],
@@ -115,12 +121,14 @@ func NewABCIMiddleware[
 	slotBroker *broker.Broker[*asynctypes.Event[SlotDataT]],
 	valUpdateSub chan *asynctypes.Event[transition.ValidatorUpdates],
 ) *ABCIMiddleware[
-	AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DepositT,
-	ExecutionPayloadT, GenesisT, SlotDataT,
+	AttestationDataT, AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+	BlobSidecarsT, DepositT, ExecutionPayloadT, GenesisT, SlashingInfoT,
+	SlotDataT,
 ] {
 	return &ABCIMiddleware[
-		AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DepositT,
-		ExecutionPayloadT, GenesisT, SlotDataT,
+		AttestationDataT, AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+		BlobSidecarsT, DepositT, ExecutionPayloadT, GenesisT, SlashingInfoT,
+		SlotDataT,
 	]{
 		chainSpec: chainSpec,
 		blobGossiper: rp2p.NewNoopBlobHandler[
@@ -152,22 +160,35 @@ func NewABCIMiddleware[
 
 // Name returns the name of the middleware.
 func (am *ABCIMiddleware[
-	AvailabilityStoreT, BeaconBlockT, BlobSidecarsT, DepositT,
-	ExecutionPayloadT, GenesisT, SlotDataT,
+	AttestationDataT, AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
+	BlobSidecarsT, DepositT, ExecutionPayloadT, GenesisT, SlashingInfoT,
+	SlotDataT,
 ]) Name() string {
 	return "abci-middleware"
 }
 
 // Start the middleware.
 func (am *ABCIMiddleware[
-	_, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
-	subBlkCh, err := am.blkBroker.Subscribe()
+	// The middleware waits on these events to answer CometBFT, so they must
+	// never be dropped.
+	subBlkCh, err := am.blkBroker.SubscribeWithOptions(
+		broker.WithEventIDs(
+			events.BeaconBlockBuilt, events.BeaconBlockVerified,
+		),
+		broker.WithTimeout(0),
+	)
 	if err != nil {
 		return err
 	}
 
-	subSidecarsCh, err := am.sidecarsBroker.Subscribe()
+	subSidecarsCh, err := am.sidecarsBroker.SubscribeWithOptions(
+		broker.WithEventIDs(
+			events.BlobSidecarsBuilt, events.BlobSidecarsProcessed,
+		),
+		broker.WithTimeout(0),
+	)
 	if err != nil {
 		return err
 	}
@@ -178,7 +199,7 @@ func (am *ABCIMiddleware[
 
 // start starts the middleware.
 func (am *ABCIMiddleware[
-	_, BeaconBlockT, BlobSidecarsT, _, _, _, _,
+	_, _, BeaconBlockT, _, BlobSidecarsT, _, _, _, _, _,
 ]) start(
 	ctx context.Context,
 	blkCh chan *asynctypes.Event[BeaconBlockT],
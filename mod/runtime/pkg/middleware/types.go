@@ -31,14 +31,31 @@ import (
 )
 
 // BeaconBlock is an interface for accessing the beacon block.
-type BeaconBlock[SelfT any] interface {
+type BeaconBlock[SelfT, BeaconBlockBodyT any] interface {
 	constraints.SSZMarshallable
 	constraints.Nillable
 	constraints.Empty[SelfT]
 	GetSlot() math.Slot
+	GetBody() BeaconBlockBodyT
 	NewFromSSZ([]byte, uint32) (SelfT, error)
 }
 
+// BeaconBlockBody is an interface for accessing the beacon block body.
+type BeaconBlockBody[AttestationDataT, SlashingInfoT any] interface {
+	// GetAttestations returns the attestations of the beacon block body.
+	GetAttestations() []AttestationDataT
+	// GetSlashingInfo returns the slashing info of the beacon block body.
+	GetSlashingInfo() []SlashingInfoT
+}
+
+// SlotData is an interface for accessing the data of the incoming slot.
+type SlotData[AttestationDataT, SlashingInfoT any] interface {
+	// GetAttestationData returns the attestation data of the incoming slot.
+	GetAttestationData() []AttestationDataT
+	// GetSlashingInfo returns the slashing info of the incoming slot.
+	GetSlashingInfo() []SlashingInfoT
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// MeasureSince measures the time since the given time.
@@ -46,6 +46,77 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrDepositIndexMismatch is returned when a deposit is processed out of
+	// the order of the deposit contract.
+	ErrDepositIndexMismatch = errors.New("deposit index mismatch")
+
+	// ErrInvalidDepositProof is returned when the Merkle branch of a deposit
+	// does not verify against the deposit root.
+	ErrInvalidDepositProof = errors.New("invalid deposit merkle proof")
+
+	// ErrDepositCountMismatch is returned when a block does not include
+	// every outstanding deposit, up to the maximum deposits per block.
+	ErrDepositCountMismatch = errors.New("deposit count mismatch")
+
+	// ErrUnexpectedExecutionRequests is returned when a block includes
+	// execution requests before they are supported by the active fork.
+	ErrUnexpectedExecutionRequests = errors.New(
+		"unexpected execution requests",
+	)
+
+	// ErrDepositCountDecreased is returned when the deposit count of the
+	// eth1 data in a block is lower than the one in the state.
+	ErrDepositCountDecreased = errors.New("eth1 data deposit count decreased")
+
+	// ErrDepositRootChanged is returned when the deposit root of the eth1
+	// data in a block differs from the one in the state, while the deposit
+	// count is unchanged.
+	ErrDepositRootChanged = errors.New(
+		"eth1 data deposit root changed without new deposits",
+	)
+
+	// ErrExceedsBlockVoluntaryExitLimit is returned when the block exceeds
+	// the voluntary exit limit.
+	ErrExceedsBlockVoluntaryExitLimit = errors.New(
+		"block exceeds voluntary exit limit")
+
+	// ErrValidatorNotActive is returned when a voluntary exit is submitted
+	// for a validator that is not active.
+	ErrValidatorNotActive = errors.New("validator is not active")
+
+	// ErrValidatorAlreadyExiting is returned when a voluntary exit is
+	// submitted for a validator that has already initiated an exit.
+	ErrValidatorAlreadyExiting = errors.New(
+		"validator has already initiated an exit")
+
+	// ErrVoluntaryExitNotYetValid is returned when a voluntary exit is
+	// processed before the epoch it specifies.
+	ErrVoluntaryExitNotYetValid = errors.New("voluntary exit is not yet valid")
+
+	// ErrValidatorTooYoungToExit is returned when a voluntary exit is
+	// submitted for a validator that has not been active for long enough.
+	ErrValidatorTooYoungToExit = errors.New(
+		"validator has not been active long enough to exit")
+
+	// ErrAttestationSlotMismatch is returned when an attestation included in
+	// a block was not made for the slot of the block.
+	ErrAttestationSlotMismatch = errors.New("attestation slot mismatch")
+
+	// ErrAttestationIndexOutOfRange is returned when an attestation refers to
+	// a validator index that is not in the registry.
+	ErrAttestationIndexOutOfRange = errors.New(
+		"attestation validator index out of range")
+
+	// ErrAttestationsNotSorted is returned when the attestations in a block
+	// are not strictly sorted by validator index.
+	ErrAttestationsNotSorted = errors.New(
+		"attestations not sorted by validator index")
+
+	// ErrSlashingInfoFromFuture is returned when the slashing info included
+	// in a block refers to a misbehavior after the slot of the block.
+	ErrSlashingInfoFromFuture = errors.New(
+		"slashing info is for a future slot")
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -65,6 +65,7 @@ type ReadOnlyBeaconState[
 	ValidatorT, ValidatorsT, WithdrawalT any,
 ] interface {
 	ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT]
+	ReadOnlyParticipation
 	ReadOnlyRandaoMixes
 	ReadOnlyStateRoots
 	ReadOnlyValidators[ValidatorT]
@@ -95,6 +96,7 @@ type WriteOnlyBeaconState[
 	ForkT, ValidatorT any,
 ] interface {
 	WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT]
+	WriteOnlyParticipation
 	WriteOnlyRandaoMixes
 	WriteOnlyStateRoots
 	WriteOnlyValidators[ValidatorT]
@@ -109,6 +111,12 @@ type WriteOnlyBeaconState[
 	UpdateSlashingAtIndex(uint64, math.Gwei) error
 	SetNextWithdrawalIndex(uint64) error
 	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
+	AddPendingPartialWithdrawal(
+		idx math.ValidatorIndex,
+		amount math.Gwei,
+		withdrawableEpoch math.Epoch,
+	) error
+	DequeuePendingPartialWithdrawals(count uint64) error
 	SetTotalSlashing(math.Gwei) error
 }
 
@@ -136,6 +144,21 @@ type ReadOnlyRandaoMixes interface {
 	GetRandaoMixAtIndex(uint64) (common.Bytes32, error)
 }
 
+// WriteOnlyParticipation has write access to the participation and
+// inactivity tracking of validators.
+type WriteOnlyParticipation interface {
+	SetEpochParticipation(math.ValidatorIndex, uint64) error
+	ResetEpochParticipation() error
+	SetInactivityScore(math.ValidatorIndex, uint64) error
+}
+
+// ReadOnlyParticipation has read access to the participation and inactivity
+// tracking of validators.
+type ReadOnlyParticipation interface {
+	GetEpochParticipation(math.ValidatorIndex) (uint64, error)
+	GetInactivityScore(math.ValidatorIndex) (uint64, error)
+}
+
 // WriteOnlyValidators has write access to validator methods.
 type WriteOnlyValidators[ValidatorT any] interface {
 	UpdateValidatorAtIndex(
@@ -179,4 +202,12 @@ type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 // ReadOnlyWithdrawals only has read access to withdrawal methods.
 type ReadOnlyWithdrawals[WithdrawalT any] interface {
 	ExpectedWithdrawals() ([]WithdrawalT, error)
+	ExpectedWithdrawalsAndPartialsCount() ([]WithdrawalT, uint64, error)
+	WalkPendingPartialWithdrawals(
+		fn func(
+			idx math.ValidatorIndex,
+			amount math.Gwei,
+			withdrawableEpoch math.Epoch,
+		) (bool, error),
+	) error
 }
@@ -100,6 +100,25 @@ type KVStore[
 	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
 	// SetNextWithdrawalValidatorIndex sets the next withdrawal validator index.
 	SetNextWithdrawalValidatorIndex(index math.ValidatorIndex) error
+	// AddPendingPartialWithdrawal appends a pending partial withdrawal to the
+	// queue.
+	AddPendingPartialWithdrawal(
+		idx math.ValidatorIndex,
+		amount math.Gwei,
+		withdrawableEpoch math.Epoch,
+	) error
+	// WalkPendingPartialWithdrawals calls fn on the pending partial
+	// withdrawals in the order of the queue, until fn returns true.
+	WalkPendingPartialWithdrawals(
+		fn func(
+			idx math.ValidatorIndex,
+			amount math.Gwei,
+			withdrawableEpoch math.Epoch,
+		) (bool, error),
+	) error
+	// DequeuePendingPartialWithdrawals removes the given number of pending
+	// partial withdrawals from the front of the queue.
+	DequeuePendingPartialWithdrawals(count uint64) error
 	// GetTotalSlashing retrieves the total slashing.
 	GetTotalSlashing() (math.Gwei, error)
 	// SetTotalSlashing sets the total slashing.
@@ -112,6 +131,18 @@ type KVStore[
 	SetSlashingAtIndex(index uint64, amount math.Gwei) error
 	// GetSlashingAtIndex retrieves the slashing at the given index.
 	GetSlashingAtIndex(index uint64) (math.Gwei, error)
+	// GetEpochParticipation retrieves the number of attestations included
+	// for the validator in the current epoch.
+	GetEpochParticipation(idx math.ValidatorIndex) (uint64, error)
+	// SetEpochParticipation sets the number of attestations included for the
+	// validator in the current epoch.
+	SetEpochParticipation(idx math.ValidatorIndex, count uint64) error
+	// ResetEpochParticipation clears the participation of all validators.
+	ResetEpochParticipation() error
+	// GetInactivityScore retrieves the inactivity score of the validator.
+	GetInactivityScore(idx math.ValidatorIndex) (uint64, error)
+	// SetInactivityScore sets the inactivity score of the validator.
+	SetInactivityScore(idx math.ValidatorIndex, score uint64) error
 	// GetTotalValidators retrieves the total validators.
 	GetTotalValidators() (uint64, error)
 	// GetTotalActiveBalances retrieves the total active balances.
@@ -23,6 +23,7 @@ package state
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -37,6 +38,7 @@ type StateDB[
 		Eth1DataT,
 		ExecutionPayloadHeaderT,
 		ForkT,
+		PendingPartialWithdrawalT,
 		ValidatorT,
 	],
 	Eth1DataT,
@@ -51,6 +53,7 @@ type StateDB[
 		ValidatorT,
 		ValidatorsT,
 	],
+	PendingPartialWithdrawalT PendingPartialWithdrawal[PendingPartialWithdrawalT],
 	ValidatorT Validator[WithdrawalCredentialsT],
 	ValidatorsT ~[]ValidatorT,
 	WithdrawalT Withdrawal[WithdrawalT],
@@ -72,7 +75,8 @@ type StateDB[
 func (s *StateDB[
 	BeaconBlockHeaderT, BeaconStateMarshallableT,
 	Eth1DataT, ExecutionPayloadHeaderT, ForkT, KVStoreT,
-	ValidatorT, ValidatorsT, WithdrawalT, WithdrawalCredentialsT,
+	PendingPartialWithdrawalT, ValidatorT, ValidatorsT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) NewFromDB(
 	bdb KVStoreT,
 	cs common.ChainSpec,
@@ -83,6 +87,7 @@ func (s *StateDB[
 	ExecutionPayloadHeaderT,
 	ForkT,
 	KVStoreT,
+	PendingPartialWithdrawalT,
 	ValidatorT,
 	ValidatorsT,
 	WithdrawalT,
@@ -95,6 +100,7 @@ func (s *StateDB[
 		ExecutionPayloadHeaderT,
 		ForkT,
 		KVStoreT,
+		PendingPartialWithdrawalT,
 		ValidatorT,
 		ValidatorsT,
 		WithdrawalT,
@@ -109,7 +115,8 @@ func (s *StateDB[
 func (s *StateDB[
 	BeaconBlockHeaderT, BeaconStateMarshallableT,
 	Eth1DataT, ExecutionPayloadHeaderT, ForkT, KVStoreT,
-	ValidatorT, ValidatorsT, WithdrawalT, WithdrawalCredentialsT,
+	PendingPartialWithdrawalT, ValidatorT, ValidatorsT, WithdrawalT,
+	WithdrawalCredentialsT,
 ]) Copy() *StateDB[
 	BeaconBlockHeaderT,
 	BeaconStateMarshallableT,
@@ -117,6 +124,7 @@ func (s *StateDB[
 	ExecutionPayloadHeaderT,
 	ForkT,
 	KVStoreT,
+	PendingPartialWithdrawalT,
 	ValidatorT,
 	ValidatorsT,
 	WithdrawalT,
@@ -127,7 +135,7 @@ func (s *StateDB[
 
 // IncreaseBalance increases the balance of a validator.
 func (s *StateDB[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) IncreaseBalance(
 	idx math.ValidatorIndex,
 	delta math.Gwei,
@@ -141,7 +149,7 @@ func (s *StateDB[
 
 // DecreaseBalance decreases the balance of a validator.
 func (s *StateDB[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) DecreaseBalance(
 	idx math.ValidatorIndex,
 	delta math.Gwei,
@@ -155,7 +163,7 @@ func (s *StateDB[
 
 // UpdateSlashingAtIndex sets the slashing amount in the store.
 func (s *StateDB[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) UpdateSlashingAtIndex(
 	index uint64,
 	amount math.Gwei,
@@ -183,40 +191,111 @@ func (s *StateDB[
 	return s.SetSlashingAtIndex(index, amount)
 }
 
-// ExpectedWithdrawals as defined in the Ethereum 2.0 Specification:
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-get_expected_withdrawals
-//
-//nolint:lll
+// ExpectedWithdrawals returns the withdrawals expected in the next execution
+// payload.
 func (s *StateDB[
-	_, _, _, _, _, _, ValidatorT, _, WithdrawalT, _,
+	_, _, _, _, _, _, _, _, _, WithdrawalT, _,
 ]) ExpectedWithdrawals() ([]WithdrawalT, error) {
+	withdrawals, _, err := s.ExpectedWithdrawalsAndPartialsCount()
+	return withdrawals, err
+}
+
+// ExpectedWithdrawalsAndPartialsCount as defined in the Ethereum 2.0
+// Specification. The pending partial withdrawals that are due are withdrawn
+// before the validators of the sweep. It also returns the number of pending
+// partial withdrawals processed, which are to be removed from the queue.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-get_expected_withdrawals
+//
+//nolint:lll,funlen,gocognit // spec.
+func (s *StateDB[
+	_, _, _, _, _, _, _, ValidatorT, _, WithdrawalT, _,
+]) ExpectedWithdrawalsAndPartialsCount() ([]WithdrawalT, uint64, error) {
 	var (
-		validator         ValidatorT
-		balance           math.Gwei
-		withdrawalAddress common.ExecutionAddress
-		withdrawals       = make([]WithdrawalT, 0)
+		validator           ValidatorT
+		balance             math.Gwei
+		withdrawalAddress   common.ExecutionAddress
+		withdrawals         = make([]WithdrawalT, 0)
+		maxEffectiveBalance = math.Gwei(s.cs.MaxEffectiveBalance())
+		processedPartials   uint64
+		// partiallyWithdrawn tracks the balance withdrawn from each
+		// validator by the pending partial withdrawals.
+		partiallyWithdrawn = make(map[math.ValidatorIndex]math.Gwei)
 	)
 
 	slot, err := s.GetSlot()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	epoch := math.Epoch(slot.Unwrap() / s.cs.SlotsPerEpoch())
 
 	withdrawalIndex, err := s.GetNextWithdrawalIndex()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	// Leave room for at least one withdrawal of the sweep so that it
+	// always makes progress.
+	partialsLimit := min(
+		constants.MaxPendingPartialsPerWithdrawalsSweep,
+		s.cs.MaxWithdrawalsPerPayload()-1,
+	)
+	if err = s.WalkPendingPartialWithdrawals(func(
+		idx math.ValidatorIndex,
+		amount math.Gwei,
+		withdrawableEpoch math.Epoch,
+	) (bool, error) {
+		if withdrawableEpoch > epoch ||
+			uint64(len(withdrawals)) == partialsLimit {
+			return true, nil
+		}
+		processedPartials++
+
+		validator, err = s.ValidatorByIndex(idx)
+		if err != nil {
+			return true, err
+		}
+
+		balance, err = s.GetBalance(idx)
+		if err != nil {
+			return true, err
+		}
+		balance -= min(balance, partiallyWithdrawn[idx])
+
+		// The validator must not be exiting and only its excess balance
+		// may be withdrawn.
+		if validator.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) ||
+			validator.GetEffectiveBalance() < maxEffectiveBalance ||
+			balance <= maxEffectiveBalance {
+			return false, nil
+		}
+
+		withdrawalAddress, err = validator.
+			GetWithdrawalCredentials().ToExecutionAddress()
+		if err != nil {
+			return true, err
+		}
+
+		var withdrawal WithdrawalT
+		amount = min(balance-maxEffectiveBalance, amount)
+		withdrawals = append(withdrawals, withdrawal.New(
+			math.U64(withdrawalIndex), idx, withdrawalAddress, amount,
+		))
+		partiallyWithdrawn[idx] += amount
+		withdrawalIndex++
+		return false, nil
+	}); err != nil {
+		return nil, 0, err
 	}
 
 	validatorIndex, err := s.GetNextWithdrawalValidatorIndex()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	totalValidators, err := s.GetTotalValidators()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	bound := min(
@@ -231,18 +310,19 @@ func (s *StateDB[
 		)
 		validator, err = s.ValidatorByIndex(validatorIndex)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		balance, err = s.GetBalance(validatorIndex)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
+		balance -= min(balance, partiallyWithdrawn[validatorIndex])
 
 		withdrawalAddress, err = validator.
 			GetWithdrawalCredentials().ToExecutionAddress()
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		// Set the amount of the withdrawal depending on the balance of the
@@ -250,9 +330,9 @@ func (s *StateDB[
 		if validator.IsFullyWithdrawable(balance, epoch) {
 			amount = balance
 		} else if validator.IsPartiallyWithdrawable(
-			balance, math.Gwei(s.cs.MaxEffectiveBalance()),
+			balance, maxEffectiveBalance,
 		) {
-			amount = balance - math.Gwei(s.cs.MaxEffectiveBalance())
+			amount = balance - maxEffectiveBalance
 		}
 		withdrawal = withdrawal.New(
 			math.U64(withdrawalIndex),
@@ -278,14 +358,15 @@ func (s *StateDB[
 		)
 	}
 
-	return withdrawals, nil
+	return withdrawals, processedPartials, nil
 }
 
 // GetMarshallable is the interface for the beacon store.
 //
 //nolint:funlen,gocognit // todo fix somehow
 func (s *StateDB[
-	_, BeaconStateMarshallableT, _, _, _, _, _, _, _, _,
+	_, BeaconStateMarshallableT, _, _, _, _,
+	PendingPartialWithdrawalT, _, _, _, _,
 ]) GetMarshallable() (BeaconStateMarshallableT, error) {
 	var empty BeaconStateMarshallableT
 
@@ -378,6 +459,39 @@ func (s *StateDB[
 		return empty, err
 	}
 
+	epochParticipation := make([]uint64, len(validators))
+	inactivityScores := make([]uint64, len(validators))
+	for i := range validators {
+		idx := math.ValidatorIndex(i)
+		epochParticipation[i], err = s.GetEpochParticipation(idx)
+		if err != nil {
+			return empty, err
+		}
+		inactivityScores[i], err = s.GetInactivityScore(idx)
+		if err != nil {
+			return empty, err
+		}
+	}
+
+	var pendingPartialWithdrawals []PendingPartialWithdrawalT
+	if err = s.WalkPendingPartialWithdrawals(
+		func(
+			idx math.ValidatorIndex,
+			amount math.Gwei,
+			withdrawableEpoch math.Epoch,
+		) (bool, error) {
+			pendingPartialWithdrawals = append(
+				pendingPartialWithdrawals,
+				(*new(PendingPartialWithdrawalT)).New(
+					idx, amount, withdrawableEpoch,
+				),
+			)
+			return false, nil
+		},
+	); err != nil {
+		return empty, err
+	}
+
 	// TODO: Properly move BeaconState into full generics.
 	return (*new(BeaconStateMarshallableT)).New(
 		s.cs.ActiveForkVersionForSlot(slot),
@@ -397,12 +511,15 @@ func (s *StateDB[
 		nextWithdrawalValidatorIndex,
 		slashings,
 		totalSlashings,
+		epochParticipation,
+		inactivityScores,
+		pendingPartialWithdrawals,
 	)
 }
 
 // HashTreeRoot is the interface for the beacon store.
 func (s *StateDB[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _,
 ]) HashTreeRoot() common.Root {
 	st, err := s.GetMarshallable()
 	if err != nil {
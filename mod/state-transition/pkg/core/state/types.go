@@ -34,6 +34,7 @@ type BeaconStateMarshallable[
 	Eth1DataT,
 	ExecutionPayloadHeaderT,
 	ForkT,
+	PendingPartialWithdrawalT,
 	ValidatorT any,
 ] interface {
 	constraints.SSZMarshallableRootable
@@ -55,9 +56,22 @@ type BeaconStateMarshallable[
 		nextWithdrawalIndex uint64,
 		nextWithdrawalValidatorIndex math.U64,
 		slashings []uint64, totalSlashing math.U64,
+		epochParticipation []uint64,
+		inactivityScores []uint64,
+		pendingPartialWithdrawals []PendingPartialWithdrawalT,
 	) (T, error)
 }
 
+// PendingPartialWithdrawal represents an interface for a pending partial
+// withdrawal.
+type PendingPartialWithdrawal[T any] interface {
+	New(
+		index math.ValidatorIndex,
+		amount math.Gwei,
+		withdrawableEpoch math.Epoch,
+	) T
+}
+
 // Validator represents an interface for a validator with generic withdrawal
 // credentials. WithdrawalCredentialsT is a type parameter that must implement
 // the WithdrawalCredentials interface.
@@ -71,6 +85,10 @@ type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
 	// IsPartiallyWithdrawable checks if the validator is partially withdrawable
 	// given two Gwei amounts.
 	IsPartiallyWithdrawable(amount1 math.Gwei, amount2 math.Gwei) bool
+	// GetEffectiveBalance returns the effective balance of the validator.
+	GetEffectiveBalance() math.Gwei
+	// GetExitEpoch returns the epoch at which the validator exits.
+	GetExitEpoch() math.Epoch
 }
 
 // Withdrawal represents an interface for a withdrawal.
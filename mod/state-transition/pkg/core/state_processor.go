@@ -29,18 +29,22 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // StateProcessor is a basic Processor, which takes care of the
 // main state transition for the beacon chain.
 type StateProcessor[
+	AttestationDataT AttestationData,
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		AttestationDataT, DepositT, BeaconBlockBodyT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, SlashingInfoT,
+		VoluntaryExitT, WithdrawalRequestT, WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		BeaconBlockBodyT, AttestationDataT, DepositT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, SlashingInfoT,
+		VoluntaryExitT, WithdrawalRequestT, WithdrawalsT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -53,6 +57,7 @@ type StateProcessor[
 	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
+		GetDepositRoot() common.Root
 		GetDepositCount() math.U64
 	},
 	ExecutionPayloadT ExecutionPayload[
@@ -64,11 +69,14 @@ type StateProcessor[
 	},
 	ForkDataT ForkData[ForkDataT],
 	KVStoreT any,
+	SlashingInfoT SlashingInfo,
 	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
 	ValidatorsT interface {
 		~[]ValidatorT
 		HashTreeRoot() common.Root
 	},
+	VoluntaryExitT VoluntaryExit[ForkDataT],
+	WithdrawalRequestT WithdrawalRequest,
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalsT interface {
 		~[]WithdrawalT
@@ -89,15 +97,16 @@ type StateProcessor[
 
 // NewStateProcessor creates a new state processor.
 func NewStateProcessor[
+	AttestationDataT AttestationData,
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		AttestationDataT, DepositT, BeaconBlockBodyT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, SlashingInfoT,
+		VoluntaryExitT, WithdrawalRequestT, WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT,
-		DepositT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT,
-		WithdrawalsT,
+		BeaconBlockBodyT, AttestationDataT, DepositT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, SlashingInfoT,
+		VoluntaryExitT, WithdrawalRequestT, WithdrawalsT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -108,6 +117,7 @@ func NewStateProcessor[
 	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
+		GetDepositRoot() common.Root
 		GetDepositCount() math.U64
 	},
 	ExecutionPayloadT ExecutionPayload[
@@ -119,11 +129,14 @@ func NewStateProcessor[
 	},
 	ForkDataT ForkData[ForkDataT],
 	KVStoreT any,
+	SlashingInfoT SlashingInfo,
 	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
 	ValidatorsT interface {
 		~[]ValidatorT
 		HashTreeRoot() common.Root
 	},
+	VoluntaryExitT VoluntaryExit[ForkDataT],
+	WithdrawalRequestT WithdrawalRequest,
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalsT interface {
 		~[]WithdrawalT
@@ -138,16 +151,18 @@ func NewStateProcessor[
 	],
 	signer crypto.BLSSigner,
 ) *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
-	ExecutionPayloadHeaderT, ForkT, ForkDataT, KVStoreT, ValidatorT,
-	ValidatorsT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
+	ExecutionPayloadHeaderT, ForkT, ForkDataT, KVStoreT, SlashingInfoT,
+	ValidatorT, ValidatorsT, VoluntaryExitT, WithdrawalRequestT, WithdrawalT,
+	WithdrawalsT, WithdrawalCredentialsT,
 ] {
 	return &StateProcessor[
-		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT, ForkT, ForkDataT, KVStoreT, ValidatorT,
-		ValidatorsT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
+		ExecutionPayloadHeaderT, ForkT, ForkDataT, KVStoreT, SlashingInfoT,
+		ValidatorT, ValidatorsT, VoluntaryExitT, WithdrawalRequestT,
+		WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
 	]{
 		cs:              cs,
 		executionEngine: executionEngine,
@@ -157,8 +172,8 @@ func NewStateProcessor[
 
 // Transition is the main function for processing a state transition.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT,
-	_, _, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _,
 ]) Transition(
 	ctx ContextT,
 	st BeaconStateT,
@@ -183,7 +198,7 @@ func (sp *StateProcessor[
 }
 
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) ProcessSlots(
 	st BeaconStateT, slot math.U64,
 ) (transition.ValidatorUpdates, error) {
@@ -229,7 +244,7 @@ func (sp *StateProcessor[
 
 // processSlot is run when a slot is missed.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSlot(
 	st BeaconStateT,
 ) error {
@@ -272,7 +287,8 @@ func (sp *StateProcessor[
 // ProcessBlock processes the block, it optionally verifies the
 // state root.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _,
 ]) ProcessBlock(
 	ctx ContextT,
 	st BeaconStateT,
@@ -297,11 +313,6 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// TODO:
-	//
-	// phase0.ProcessProposerSlashings
-	// phase0.ProcessAttesterSlashings
-
 	// process the randao reveal.
 	if err := sp.processRandaoReveal(
 		st, blk, ctx.GetSkipValidateRandao(),
@@ -309,9 +320,12 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// TODO:
-	//
-	// phase0.ProcessEth1Vote
+	// process the eth1 data, which is only tracked from Electra onwards.
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) >= version.Electra {
+		if err := sp.processEth1Data(st, blk.GetBody()); err != nil {
+			return err
+		}
+	}
 
 	// process the deposits and ensure they match the local state.
 	if err := sp.processOperations(st, blk); err != nil {
@@ -339,25 +353,48 @@ func (sp *StateProcessor[
 
 // processEpoch processes the epoch and ensures it matches the local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processEpoch(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
-	if err := sp.processRewardsAndPenalties(st); err != nil {
+	if err := sp.processInactivityUpdates(st); err != nil {
+		return nil, err
+	} else if err = sp.processRewardsAndPenalties(st); err != nil {
+		return nil, err
+	} else if err = sp.processRegistryUpdates(st); err != nil {
+		return nil, err
+	} else if err = sp.processSlashings(st); err != nil {
 		return nil, err
-	} else if err = sp.processSlashingsReset(st); err != nil {
+	}
+
+	// Effective balances are only recomputed from Electra onwards, as
+	// deposits raise them directly before.
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	var changed map[crypto.BLSPubkey]struct{}
+	if sp.cs.ActiveForkVersionForSlot(slot) >= version.Electra {
+		if changed, err = sp.processEffectiveBalanceUpdates(st); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = sp.processSlashingsReset(st); err != nil {
 		return nil, err
 	} else if err = sp.processRandaoMixesReset(st); err != nil {
 		return nil, err
+	} else if err = sp.processParticipationReset(st); err != nil {
+		return nil, err
 	}
-	return sp.processSyncCommitteeUpdates(st)
+	return sp.processSyncCommitteeUpdates(st, changed)
 }
 
 // processBlockHeader processes the header and ensures it matches the local
 // state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT,
-	_, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT, _, _, _, _, _, _, _,
+	_, _, ValidatorT, _, _, _, _, _, _,
 ]) processBlockHeader(
 	st BeaconStateT,
 	blk BeaconBlockT,
@@ -440,19 +477,82 @@ func (sp *StateProcessor[
 // getAttestationDeltas as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_attestation_deltas
 //
+// Attestations come from the CometBFT commit votes, so there is a single
+// attestation component. An active validator earns its base reward in
+// proportion to the slots of the epoch it attested to and is penalized in
+// proportion to the slots it missed. Once its inactivity score exceeds
+// MinEpochsToInactivityPenalty, it is further penalized in proportion to its
+// score and effective balance.
+//
 //nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) getAttestationDeltas(
 	st BeaconStateT,
 ) ([]math.Gwei, []math.Gwei, error) {
-	// TODO: implement this function forreal
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	validators, err := st.GetValidators()
 	if err != nil {
 		return nil, nil, err
 	}
-	placeholder := make([]math.Gwei, len(validators))
-	return placeholder, placeholder, nil
+
+	totalActiveBalance, err := st.GetTotalActiveBalances(
+		sp.cs.SlotsPerEpoch(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewards := make([]math.Gwei, len(validators))
+	penalties := make([]math.Gwei, len(validators))
+	sqrtBalance := totalActiveBalance.ISqrt().Unwrap()
+	if sqrtBalance == 0 {
+		return rewards, penalties, nil
+	}
+
+	var (
+		epoch         = sp.cs.SlotToEpoch(slot)
+		slotsPerEpoch = sp.cs.SlotsPerEpoch()
+		participation uint64
+		score         uint64
+	)
+	for i, val := range validators {
+		if !val.IsActive(epoch) {
+			continue
+		}
+
+		idx := math.ValidatorIndex(i)
+		if participation, err = st.GetEpochParticipation(idx); err != nil {
+			return nil, nil, err
+		}
+		if score, err = st.GetInactivityScore(idx); err != nil {
+			return nil, nil, err
+		}
+
+		// Slashed validators are not rewarded for their attestations.
+		attested := min(participation, slotsPerEpoch)
+		if val.IsSlashed() {
+			attested = 0
+		}
+
+		effectiveBalance := val.GetEffectiveBalance().Unwrap()
+		baseReward := effectiveBalance * sp.cs.BaseRewardFactor() / sqrtBalance
+		rewards[i] = math.Gwei(baseReward * attested / slotsPerEpoch)
+		penalties[i] = math.Gwei(
+			baseReward * (slotsPerEpoch - attested) / slotsPerEpoch,
+		)
+
+		if score > sp.cs.MinEpochsToInactivityPenalty() {
+			penalties[i] += math.Gwei(
+				effectiveBalance * score / sp.cs.InactivityPenaltyQuotient(),
+			)
+		}
+	}
+	return rewards, penalties, nil
 }
 
 // processRewardsAndPenalties as defined in the Ethereum 2.0 specification.
@@ -460,7 +560,7 @@ func (sp *StateProcessor[
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processRewardsAndPenalties(
 	st BeaconStateT,
 ) error {
@@ -469,7 +569,10 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	if sp.cs.SlotToEpoch(slot) == math.U64(constants.GenesisEpoch) {
+	// Attestations are only included in blocks from Electra onwards, so
+	// there is no participation to reward before.
+	if sp.cs.SlotToEpoch(slot) == math.U64(constants.GenesisEpoch) ||
+		sp.cs.ActiveForkVersionForSlot(slot) < version.Electra {
 		return nil
 	}
 
@@ -515,3 +618,61 @@ func (sp *StateProcessor[
 
 	return nil
 }
+
+// processEffectiveBalanceUpdates as defined in the Ethereum 2.0
+// specification. It returns the public keys of the validators whose effective
+// balance changed.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#effective-balances-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processEffectiveBalanceUpdates(
+	st BeaconStateT,
+) (map[crypto.BLSPubkey]struct{}, error) {
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		balance             math.Gwei
+		increment           = sp.cs.EffectiveBalanceIncrement()
+		hysteresisIncrement = increment / constants.HysteresisQuotient
+		maxEffectiveBalance = math.Gwei(sp.cs.MaxEffectiveBalance())
+		downwardThreshold   = math.Gwei(
+			hysteresisIncrement * constants.HysteresisDownwardMultiplier,
+		)
+		upwardThreshold = math.Gwei(
+			hysteresisIncrement * constants.HysteresisUpwardMultiplier,
+		)
+	)
+
+	changed := make(map[crypto.BLSPubkey]struct{})
+	for i, val := range validators {
+		idx := math.ValidatorIndex(i)
+		if balance, err = st.GetBalance(idx); err != nil {
+			return nil, err
+		}
+
+		effectiveBalance := val.GetEffectiveBalance()
+		if balance+downwardThreshold >= effectiveBalance &&
+			effectiveBalance+upwardThreshold >= balance {
+			continue
+		}
+
+		updated := min(
+			balance-balance%math.Gwei(increment), maxEffectiveBalance,
+		)
+		if updated == effectiveBalance {
+			continue
+		}
+
+		val.SetEffectiveBalance(updated)
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return nil, err
+		}
+		changed[val.GetPubkey()] = struct{}{}
+	}
+	return changed, nil
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processAttestations records the participation of the validators whose
+// attestations are included in the block. Attestations are built from the
+// CometBFT commit votes, so there is at most one per validator and they are
+// sorted by validator index.
+func (sp *StateProcessor[
+	AttestationDataT, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _,
+]) processAttestations(
+	st BeaconStateT,
+	attestations []AttestationDataT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	totalValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		idx, prev     math.ValidatorIndex
+		participation uint64
+	)
+	for i, attestation := range attestations {
+		if attestation.GetSlot() != slot {
+			return errors.Wrapf(
+				ErrAttestationSlotMismatch, "expected %d, got %d",
+				slot, attestation.GetSlot(),
+			)
+		}
+
+		idx = attestation.GetIndex()
+		if idx.Unwrap() >= totalValidators {
+			return errors.Wrapf(
+				ErrAttestationIndexOutOfRange, "index %d, validators %d",
+				idx, totalValidators,
+			)
+		} else if i > 0 && idx <= prev {
+			return errors.Wrapf(
+				ErrAttestationsNotSorted, "index %d follows %d", idx, prev,
+			)
+		}
+		prev = idx
+
+		if participation, err = st.GetEpochParticipation(idx); err != nil {
+			return err
+		}
+		if err = st.SetEpochParticipation(idx, participation+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processInactivityUpdates updates the inactivity scores of the active
+// validators at the end of the epoch. The score of a validator without any
+// attestation included during the epoch is increased by one, while the score
+// of a validator that did participate recovers by one.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#inactivity-scores
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processInactivityUpdates(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	// Attestations are only included in blocks from Electra onwards, so
+	// there is no participation to account for before.
+	epoch := sp.cs.SlotToEpoch(slot)
+	if epoch == math.Epoch(constants.GenesisEpoch) ||
+		sp.cs.ActiveForkVersionForEpoch(epoch) < version.Electra {
+		return nil
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var participation, score uint64
+	for i, val := range validators {
+		if !val.IsActive(epoch) {
+			continue
+		}
+
+		idx := math.ValidatorIndex(i)
+		if participation, err = st.GetEpochParticipation(idx); err != nil {
+			return err
+		}
+		if score, err = st.GetInactivityScore(idx); err != nil {
+			return err
+		}
+
+		switch {
+		case participation == 0 || val.IsSlashed():
+			score++
+		case score > 0:
+			score--
+		default:
+			continue
+		}
+
+		if err = st.SetInactivityScore(idx, score); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processParticipationReset clears the participation of all validators so
+// that the next epoch is tracked from scratch.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#participation-flags-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processParticipationReset(
+	st BeaconStateT,
+) error {
+	return st.ResetEpochParticipation()
+}
@@ -21,29 +21,63 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
-	"github.com/sourcegraph/conc/iter"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
-// processSyncCommitteeUpdates processes the sync committee updates.
+// processSyncCommitteeUpdates processes the sync committee updates. Before
+// Electra, every validator is sent with its effective balance. From Electra
+// onwards, the updates take effect from the next epoch and only carry the
+// validators whose voting power changes: a validator exiting at that epoch is
+// removed from the set, while a validator activating at that epoch or active
+// and whose public key is in changed is sent with its effective balance.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _,
 ]) processSyncCommitteeUpdates(
 	st BeaconStateT,
+	changed map[crypto.BLSPubkey]struct{},
 ) (transition.ValidatorUpdates, error) {
 	vals, err := st.GetValidatorsByEffectiveBalance()
 	if err != nil {
 		return nil, err
 	}
 
-	return iter.MapErr(
-		vals,
-		func(val *ValidatorT) (*transition.ValidatorUpdate, error) {
-			v := (*val)
-			return &transition.ValidatorUpdate{
-				Pubkey:           v.GetPubkey(),
-				EffectiveBalance: v.GetEffectiveBalance(),
-			}, nil
-		},
-	)
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	if sp.cs.ActiveForkVersionForSlot(slot) < version.Electra {
+		updates := make(transition.ValidatorUpdates, len(vals))
+		for i, val := range vals {
+			updates[i] = &transition.ValidatorUpdate{
+				Pubkey:           val.GetPubkey(),
+				EffectiveBalance: val.GetEffectiveBalance(),
+			}
+		}
+		return updates, nil
+	}
+	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
+
+	updates := make(transition.ValidatorUpdates, 0, len(changed))
+	for _, val := range vals {
+		_, ok := changed[val.GetPubkey()]
+		switch exitEpoch := val.GetExitEpoch(); {
+		case exitEpoch < nextEpoch:
+			continue
+		case exitEpoch == nextEpoch:
+			updates = append(updates, &transition.ValidatorUpdate{
+				Pubkey:           val.GetPubkey(),
+				EffectiveBalance: 0,
+			})
+		case (ok && val.IsActive(nextEpoch)) ||
+			val.GetActivationEpoch() == nextEpoch:
+			updates = append(updates, &transition.ValidatorUpdate{
+				Pubkey:           val.GetPubkey(),
+				EffectiveBalance: val.GetEffectiveBalance(),
+			})
+		}
+	}
+	return updates, nil
 }
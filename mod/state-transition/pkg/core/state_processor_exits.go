@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processVoluntaryExits processes the voluntary exits and ensures they match
+// the local state.
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, VoluntaryExitT,
+	_, _, _, _,
+]) processVoluntaryExits(
+	st BeaconStateT,
+	exits []VoluntaryExitT,
+) error {
+	if uint64(len(exits)) > sp.cs.MaxVoluntaryExitsPerBlock() {
+		return errors.Wrapf(
+			ErrExceedsBlockVoluntaryExitLimit, "expected: <= %d, got: %d",
+			sp.cs.MaxVoluntaryExitsPerBlock(), len(exits),
+		)
+	}
+
+	for _, exit := range exits {
+		if err := sp.processVoluntaryExit(st, exit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntary-exits
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, VoluntaryExitT,
+	_, _, _, _,
+]) processVoluntaryExit(
+	st BeaconStateT,
+	exit VoluntaryExitT,
+) error {
+	if err := sp.ValidateVoluntaryExit(st, exit); err != nil {
+		return err
+	}
+	return sp.initiateValidatorExit(st, exit.GetValidatorIndex())
+}
+
+// ValidateVoluntaryExit checks that the voluntary exit may be applied to the
+// given state, without modifying it.
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _, _, _,
+	VoluntaryExitT, _, _, _, _,
+]) ValidateVoluntaryExit(
+	st BeaconStateT,
+	exit VoluntaryExitT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	val, err := st.ValidatorByIndex(exit.GetValidatorIndex())
+	if err != nil {
+		return err
+	}
+
+	// Verify the validator is active and has not already initiated an exit.
+	switch {
+	case !val.IsActive(epoch):
+		return errors.Wrapf(
+			ErrValidatorNotActive, "validator index: %d",
+			exit.GetValidatorIndex(),
+		)
+	case val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch):
+		return errors.Wrapf(
+			ErrValidatorAlreadyExiting, "validator index: %d, exit epoch: %d",
+			exit.GetValidatorIndex(), val.GetExitEpoch(),
+		)
+	case epoch < exit.GetEpoch():
+		return errors.Wrapf(
+			ErrVoluntaryExitNotYetValid, "current epoch: %d, exit epoch: %d",
+			epoch, exit.GetEpoch(),
+		)
+	case epoch < val.GetActivationEpoch()+
+		math.Epoch(sp.cs.ShardCommitteePeriod()):
+		return errors.Wrapf(
+			ErrValidatorTooYoungToExit, "activation epoch: %d, current: %d",
+			val.GetActivationEpoch(), epoch,
+		)
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	// The exit is signed over the fork active at the epoch it specifies.
+	var fd ForkDataT
+	return exit.VerifySignature(
+		fd.New(
+			version.FromUint32[common.Version](
+				sp.cs.ActiveForkVersionForEpoch(exit.GetEpoch()),
+			), genesisValidatorsRoot,
+		),
+		sp.cs.DomainTypeVoluntaryExit(),
+		val.GetPubkey(),
+		sp.signer.VerifySignature,
+	)
+}
+
+// initiateValidatorExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) error {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Return if the validator has already initiated an exit.
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	vals, err := st.GetValidatorsByEffectiveBalance()
+	if err != nil {
+		return err
+	}
+
+	// Compute the exit queue epoch, which is the latest epoch any validator
+	// is scheduled to exit at, and the balance exiting at that epoch.
+	var (
+		exitQueueEpoch     = sp.computeActivationExitEpoch(epoch)
+		exitQueueBalance   math.Gwei
+		totalActiveBalance math.Gwei
+	)
+	for _, v := range vals {
+		if v.IsActive(epoch) {
+			totalActiveBalance += v.GetEffectiveBalance()
+		}
+		switch exitEpoch := v.GetExitEpoch(); {
+		case exitEpoch == math.Epoch(constants.FarFutureEpoch):
+			continue
+		case exitEpoch > exitQueueEpoch:
+			exitQueueEpoch = exitEpoch
+			exitQueueBalance = v.GetEffectiveBalance()
+		case exitEpoch == exitQueueEpoch:
+			exitQueueBalance += v.GetEffectiveBalance()
+		}
+	}
+
+	// Push the exit back an epoch if it does not fit in the churn of the
+	// queue epoch. A validator always fits in an otherwise empty epoch.
+	if exitQueueBalance > 0 && exitQueueBalance+val.GetEffectiveBalance() >
+		sp.getBalanceChurnLimit(totalActiveBalance) {
+		exitQueueEpoch++
+	}
+
+	val.SetExitEpoch(exitQueueEpoch)
+	val.SetWithdrawableEpoch(
+		exitQueueEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+	)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// computeActivationExitEpoch as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_activation_exit_epoch
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) computeActivationExitEpoch(epoch math.Epoch) math.Epoch {
+	return epoch + 1 + math.Epoch(sp.cs.MaxSeedLookahead())
+}
+
+// getBalanceChurnLimit returns the effective balance that may activate or
+// exit per epoch, as defined in the Electra specification. The lower bound is
+// MinPerEpochChurnLimit validators at the maximum effective balance.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-get_balance_churn_limit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) getBalanceChurnLimit(totalActiveBalance math.Gwei) math.Gwei {
+	churn := max(
+		math.Gwei(sp.cs.MinPerEpochChurnLimit()*sp.cs.MaxEffectiveBalance()),
+		totalActiveBalance/math.Gwei(sp.cs.ChurnLimitQuotient()),
+	)
+	return churn - churn%math.Gwei(sp.cs.EffectiveBalanceIncrement())
+}
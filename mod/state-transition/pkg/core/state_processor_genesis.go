@@ -23,8 +23,10 @@ package core
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
@@ -39,8 +41,9 @@ const (
 //
 //nolint:gocognit,funlen // todo fix.
 func (sp *StateProcessor[
-	_, BeaconBlockBodyT, BeaconBlockHeaderT, BeaconStateT, _, DepositT,
-	Eth1DataT, _, ExecutionPayloadHeaderT, ForkT, _, _, ValidatorT, _, _, _, _,
+	_, _, BeaconBlockBodyT, BeaconBlockHeaderT, BeaconStateT, _, DepositT,
+	Eth1DataT, _, ExecutionPayloadHeaderT, ForkT, _, _, _, ValidatorT, _, _, _,
+	_, _, _,
 ]) InitializePreminedBeaconStateFromEth1(
 	st BeaconStateT,
 	deposits []DepositT,
@@ -67,15 +70,37 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// From Electra onwards, the genesis deposits make up the initial deposit
+	// tree, which the eth1 data of the genesis state refers to.
+	electra := sp.cs.ActiveForkVersionForEpoch(
+		math.Epoch(constants.GenesisEpoch),
+	) >= version.Electra
+	genesisEth1Data := eth1Data.New(
+		common.Root{}, 0, executionPayloadHeader.GetBlockHash(),
+	)
+	if electra {
+		leaves := make([]common.Root, len(deposits))
+		for i, deposit := range deposits {
+			leaves[i] = deposit.GetDepositDataRoot()
+		}
+		depositTree, err := merkle.NewTreeFromLeavesWithDepth(
+			leaves, constants.DepositContractTreeDepth,
+		)
+		if err != nil {
+			return nil, err
+		}
+		genesisEth1Data = eth1Data.New(
+			depositTree.HashTreeRoot(),
+			math.U64(len(deposits)),
+			executionPayloadHeader.GetBlockHash(),
+		)
+	}
+
 	if err := st.SetEth1DepositIndex(0); err != nil {
 		return nil, err
 	}
 
-	if err := st.SetEth1Data(eth1Data.New(
-		common.Root{},
-		0,
-		executionPayloadHeader.GetBlockHash(),
-	)); err != nil {
+	if err := st.SetEth1Data(genesisEth1Data); err != nil {
 		return nil, err
 	}
 
@@ -98,6 +123,8 @@ func (sp *StateProcessor[
 		}
 	}
 
+	// The genesis deposits define the deposit root, so they are processed
+	// without verifying their Merkle branches.
 	for _, deposit := range deposits {
 		if err := sp.processDeposit(st, deposit); err != nil {
 			return nil, err
@@ -110,6 +137,30 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// All genesis validators enter the validator set. From Electra onwards,
+	// their effective balance reflects every genesis deposit, including
+	// top-ups, which only increase their balance.
+	genesisValidators := make(map[crypto.BLSPubkey]struct{}, len(validators))
+	for i, val := range validators {
+		genesisValidators[val.GetPubkey()] = struct{}{}
+		if !electra || sp.cs.DepositEth1ChainID() == bArtioChainID {
+			continue
+		}
+
+		var balance math.Gwei
+		idx := math.ValidatorIndex(i)
+		if balance, err = st.GetBalance(idx); err != nil {
+			return nil, err
+		}
+		val.SetEffectiveBalance(min(
+			balance-balance%math.Gwei(sp.cs.EffectiveBalanceIncrement()),
+			math.Gwei(sp.cs.MaxEffectiveBalance()),
+		))
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return nil, err
+		}
+	}
+
 	// Handle special case bartio genesis.
 	if sp.cs.DepositEth1ChainID() == bArtioChainID {
 		if err = st.SetGenesisValidatorsRoot(
@@ -153,7 +204,7 @@ func (sp *StateProcessor[
 	}
 
 	var updates transition.ValidatorUpdates
-	updates, err = sp.processSyncCommitteeUpdates(st)
+	updates, err = sp.processSyncCommitteeUpdates(st, genesisValidators)
 	if err != nil {
 		return nil, err
 	}
@@ -31,8 +31,8 @@ import (
 // processExecutionPayload processes the execution payload and ensures it
 // matches the local state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT,
-	_, _, _, ExecutionPayloadHeaderT, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _,
+	ExecutionPayloadHeaderT, _, _, _, _, _, _, _, _, _, _, _,
 ]) processExecutionPayload(
 	ctx ContextT,
 	st BeaconStateT,
@@ -79,8 +79,8 @@ func (sp *StateProcessor[
 // state
 // and the execution engine.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT,
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
 ]) validateExecutionPayload(
 	ctx context.Context,
 	st BeaconStateT,
@@ -110,13 +110,20 @@ func (sp *StateProcessor[
 		)
 	}
 
+	executionRequests, err := body.GetExecutionRequestsList()
+	if err != nil {
+		return err
+	}
+
 	parentBeaconBlockRoot := blk.GetParentBlockRoot()
 	if err = sp.executionEngine.VerifyAndNotifyNewPayload(
 		ctx, engineprimitives.BuildNewPayloadRequest(
 			payload,
 			body.GetBlobKzgCommitments().ToVersionedHashes(),
 			&parentBeaconBlockRoot,
+			executionRequests,
 			optimisticEngine,
+			sp.cs.ActiveForkVersionForSlot(blk.GetSlot()),
 		),
 	); err != nil {
 		return err
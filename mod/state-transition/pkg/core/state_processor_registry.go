@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processRegistryUpdates as defined in the Ethereum 2.0 specification. As
+// blocks are final once committed, a validator is dequeued for activation as
+// soon as its eligibility epoch has been reached. The activation queue is
+// bounded by the balance churn limit, so that a burst of deposits cannot
+// swing the voting power in a single epoch. The registry is only updated from
+// Electra onwards, and the validators added before are activated at the
+// fork.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#registry-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processRegistryUpdates(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+	switch {
+	case sp.cs.ActiveForkVersionForEpoch(epoch) >= version.Electra:
+	case sp.cs.ActiveForkVersionForEpoch(epoch+1) >= version.Electra:
+		return sp.activateValidatorsAtFork(st, epoch+1)
+	default:
+		return nil
+	}
+
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	totalActiveBalance, err := st.GetTotalActiveBalances(sp.cs.SlotsPerEpoch())
+	if err != nil {
+		return err
+	}
+
+	updates := computeRegistryUpdates(
+		vals,
+		epoch,
+		math.Gwei(sp.cs.EjectionBalance()),
+		sp.getBalanceChurnLimit(totalActiveBalance),
+	)
+	for _, idx := range updates.eligible {
+		vals[idx].SetActivationEligibilityEpoch(epoch + 1)
+		if err = st.UpdateValidatorAtIndex(idx, vals[idx]); err != nil {
+			return err
+		}
+	}
+	for _, idx := range updates.ejected {
+		if err = sp.initiateValidatorExit(st, idx); err != nil {
+			return err
+		}
+	}
+	activationEpoch := sp.computeActivationExitEpoch(epoch)
+	for _, idx := range updates.activated {
+		vals[idx].SetActivationEpoch(activationEpoch)
+		if err = st.UpdateValidatorAtIndex(idx, vals[idx]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// activateValidatorsAtFork activates the validators added before Electra,
+// which were never activated, at the given fork epoch, such that they remain
+// in the validator set once activation is enforced.
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) activateValidatorsAtFork(
+	st BeaconStateT,
+	forkEpoch math.Epoch,
+) error {
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+	for i, val := range vals {
+		if val.GetActivationEpoch() != math.Epoch(constants.FarFutureEpoch) {
+			continue
+		}
+		val.SetActivationEligibilityEpoch(forkEpoch)
+		val.SetActivationEpoch(forkEpoch)
+		if err = st.UpdateValidatorAtIndex(
+			math.ValidatorIndex(i), val,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// registryValidator is the view of a validator needed to compute the registry
+// updates.
+type registryValidator interface {
+	GetActivationEligibilityEpoch() math.Epoch
+	GetActivationEpoch() math.Epoch
+	GetEffectiveBalance() math.Gwei
+	IsActive(epoch math.Epoch) bool
+}
+
+// registryUpdates are the indices of the validators affected by the registry
+// updates of an epoch.
+type registryUpdates struct {
+	// eligible are the validators that become eligible for activation.
+	eligible []math.ValidatorIndex
+	// ejected are the validators whose exit must be initiated.
+	ejected []math.ValidatorIndex
+	// activated are the validators dequeued for activation.
+	activated []math.ValidatorIndex
+}
+
+// computeRegistryUpdates computes the registry updates of the given epoch.
+// Validators are dequeued for activation in order of eligibility, up to the
+// churn limit. The first validator is always dequeued so that a large
+// effective balance cannot stall the queue.
+func computeRegistryUpdates[ValidatorT registryValidator](
+	vals []ValidatorT,
+	epoch math.Epoch,
+	ejectionBalance math.Gwei,
+	churnLimit math.Gwei,
+) registryUpdates {
+	var (
+		updates         registryUpdates
+		activationQueue []math.ValidatorIndex
+		farFutureEpoch  = math.Epoch(constants.FarFutureEpoch)
+	)
+	for i, val := range vals {
+		idx := math.ValidatorIndex(i)
+		switch {
+		case val.GetActivationEligibilityEpoch() == farFutureEpoch &&
+			val.GetEffectiveBalance() > ejectionBalance:
+			updates.eligible = append(updates.eligible, idx)
+		case val.IsActive(epoch) &&
+			val.GetEffectiveBalance() <= ejectionBalance:
+			updates.ejected = append(updates.ejected, idx)
+		case val.GetActivationEligibilityEpoch() <= epoch &&
+			val.GetActivationEpoch() == farFutureEpoch:
+			activationQueue = append(activationQueue, idx)
+		}
+	}
+
+	slices.SortStableFunc(activationQueue, func(a, b math.ValidatorIndex) int {
+		return cmp.Compare(
+			vals[a].GetActivationEligibilityEpoch(),
+			vals[b].GetActivationEligibilityEpoch(),
+		)
+	})
+
+	var activated math.Gwei
+	for _, idx := range activationQueue {
+		balance := vals[idx].GetEffectiveBalance()
+		if activated > 0 && activated+balance > churnLimit {
+			break
+		}
+		activated += balance
+		updates.activated = append(updates.activated, idx)
+	}
+	return updates
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	farFutureEpoch  = math.Epoch(constants.FarFutureEpoch)
+	ejectionBalance = math.Gwei(16e9)
+	maxBalance      = math.Gwei(32e9)
+)
+
+type testValidator struct {
+	eligibilityEpoch math.Epoch
+	activationEpoch  math.Epoch
+	exitEpoch        math.Epoch
+	effectiveBalance math.Gwei
+}
+
+func (v testValidator) GetActivationEligibilityEpoch() math.Epoch {
+	return v.eligibilityEpoch
+}
+
+func (v testValidator) GetActivationEpoch() math.Epoch {
+	return v.activationEpoch
+}
+
+func (v testValidator) GetEffectiveBalance() math.Gwei {
+	return v.effectiveBalance
+}
+
+func (v testValidator) IsActive(epoch math.Epoch) bool {
+	return v.activationEpoch <= epoch && epoch < v.exitEpoch
+}
+
+// pending returns a validator eligible for activation since the given epoch.
+func pending(eligibilityEpoch math.Epoch, balance math.Gwei) testValidator {
+	return testValidator{
+		eligibilityEpoch: eligibilityEpoch,
+		activationEpoch:  farFutureEpoch,
+		exitEpoch:        farFutureEpoch,
+		effectiveBalance: balance,
+	}
+}
+
+// active returns a validator active since genesis.
+func active(balance math.Gwei) testValidator {
+	return testValidator{exitEpoch: farFutureEpoch, effectiveBalance: balance}
+}
+
+func TestComputeRegistryUpdates_ActivationQueue(t *testing.T) {
+	vals := []testValidator{
+		// Not eligible for activation yet.
+		pending(farFutureEpoch, maxBalance),
+		// Eligible, but not before the next epoch.
+		pending(11, maxBalance),
+		pending(10, maxBalance),
+		pending(5, maxBalance),
+		active(maxBalance),
+	}
+
+	updates := computeRegistryUpdates(vals, 10, ejectionBalance, 4*maxBalance)
+	require.Equal(t, []math.ValidatorIndex{0}, updates.eligible)
+	require.Empty(t, updates.ejected)
+	// Dequeued in order of eligibility.
+	require.Equal(t, []math.ValidatorIndex{3, 2}, updates.activated)
+}
+
+func TestComputeRegistryUpdates_ChurnLimit(t *testing.T) {
+	vals := []testValidator{
+		pending(3, maxBalance),
+		pending(1, maxBalance),
+		pending(2, maxBalance),
+	}
+
+	updates := computeRegistryUpdates(vals, 10, ejectionBalance, 2*maxBalance)
+	require.Equal(t, []math.ValidatorIndex{1, 2}, updates.activated)
+
+	// The head of the queue is dequeued even above the churn limit.
+	updates = computeRegistryUpdates(vals, 10, ejectionBalance, maxBalance/2)
+	require.Equal(t, []math.ValidatorIndex{1}, updates.activated)
+}
+
+func TestComputeRegistryUpdates_Ejection(t *testing.T) {
+	exited := active(ejectionBalance)
+	exited.exitEpoch = 5
+	vals := []testValidator{
+		active(ejectionBalance),
+		active(ejectionBalance + 1),
+		exited,
+		pending(1, ejectionBalance),
+	}
+
+	updates := computeRegistryUpdates(vals, 10, ejectionBalance, maxBalance)
+	require.Equal(t, []math.ValidatorIndex{0}, updates.ejected)
+	require.Empty(t, updates.eligible)
+	require.Equal(t, []math.ValidatorIndex{3}, updates.activated)
+}
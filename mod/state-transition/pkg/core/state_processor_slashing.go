@@ -21,6 +21,7 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -29,7 +30,7 @@ import (
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSlashingsReset(
 	st BeaconStateT,
 ) error {
@@ -43,41 +44,125 @@ func (sp *StateProcessor[
 	return st.UpdateSlashingAtIndex(index, 0)
 }
 
-// processProposerSlashing as defined in the Ethereum 2.0 specification.
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposer-slashings
-//
-//nolint:lll,unused // will be used later
+// processSlashingInfos slashes the validators reported by the slashing info
+// of the block. The slashing info is derived from the misbehavior evidence
+// committed by CometBFT, which may report a validator that is no longer
+// slashable, e.g. one already slashed for an earlier misbehavior. Such
+// entries are skipped rather than invalidating the block.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processProposerSlashing(
-	_ BeaconStateT,
-	// ps ProposerSlashing,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, ValidatorT,
+	_, _, _, _, _, _,
+]) processSlashingInfos(
+	st BeaconStateT,
+	blk BeaconBlockT,
 ) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	for _, info := range blk.GetBody().GetSlashingInfo() {
+		if info.GetSlot() > slot {
+			return errors.Wrapf(
+				ErrSlashingInfoFromFuture, "slot: %d, slashing info slot: %d",
+				slot, info.GetSlot(),
+			)
+		}
+
+		var val ValidatorT
+		val, err = st.ValidatorByIndex(info.GetIndex())
+		if err != nil {
+			return err
+		}
+		if !val.IsSlashable(epoch) {
+			continue
+		}
+
+		if err = sp.slashValidator(
+			st, info.GetIndex(), blk.GetProposerIndex(),
+		); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-// processAttesterSlashing as defined in the Ethereum 2.0 specification.
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#attester-slashings
+// slashValidator as defined in the Ethereum 2.0 specification, with the
+// proposer of the block acting as the whistleblower. Rather than going
+// through the exit queue, the validator exits at the next epoch so that it
+// is ejected from the CometBFT validator set at the upcoming epoch boundary.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slash_validator
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) processAttesterSlashing(
-	_ BeaconStateT,
-	// as AttesterSlashing,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) slashValidator(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	proposerIdx math.ValidatorIndex,
 ) error {
-	return nil
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	if exitEpoch := epoch + 1; val.GetExitEpoch() > exitEpoch {
+		val.SetExitEpoch(exitEpoch)
+		val.SetWithdrawableEpoch(
+			exitEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+		)
+	}
+	val.SetSlashed(true)
+	val.SetWithdrawableEpoch(max(
+		val.GetWithdrawableEpoch(),
+		epoch+math.Epoch(sp.cs.EpochsPerSlashingsVector()),
+	))
+	if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+		return err
+	}
+
+	// Record the effective balance in the slashings vector, from which the
+	// proportional penalty is computed once the validator is withdrawable.
+	index := epoch.Unwrap() % sp.cs.EpochsPerSlashingsVector()
+	slashing, err := st.GetSlashingAtIndex(index)
+	if err != nil {
+		return err
+	}
+	if err = st.UpdateSlashingAtIndex(
+		index, slashing+val.GetEffectiveBalance(),
+	); err != nil {
+		return err
+	}
+
+	// Apply the initial penalty and reward the whistleblower.
+	if err = st.DecreaseBalance(
+		idx,
+		val.GetEffectiveBalance()/math.Gwei(sp.cs.MinSlashingPenaltyQuotient()),
+	); err != nil {
+		return err
+	}
+	return st.IncreaseBalance(
+		proposerIdx,
+		val.GetEffectiveBalance()/math.Gwei(sp.cs.WhistleblowerRewardQuotient()),
+	)
 }
 
 // processSlashings as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slashings
 //
-// processSlashings processes the slashings and ensures they match the local
-// state.
+// processSlashings applies the proportional slashing penalty to the
+// validators slashed EpochsPerSlashingsVector / 2 epochs ago.
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSlashings(
 	st BeaconStateT,
 ) error {
@@ -108,7 +193,7 @@ func (sp *StateProcessor[
 	}
 
 	//nolint:mnd // this is in the spec
-	slashableEpoch := (sp.cs.SlotToEpoch(slot).Unwrap() + sp.cs.EpochsPerSlashingsVector()) / 2
+	slashableEpoch := sp.cs.SlotToEpoch(slot).Unwrap() + sp.cs.EpochsPerSlashingsVector()/2
 
 	// Iterate through the validators and slash if needed.
 	for _, val := range vals {
@@ -127,10 +212,9 @@ func (sp *StateProcessor[
 }
 
 // processSlash handles the logic for slashing a validator.
-//
-//nolint:unused // will be used later
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _,
 ]) processSlash(
 	st BeaconStateT,
 	val ValidatorT,
@@ -23,7 +23,9 @@ package core
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/davecgh/go-spew/spew"
 )
@@ -31,13 +33,56 @@ import (
 // processOperations processes the operations and ensures they match the
 // local state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
 ]) processOperations(
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) error {
-	// Verify that outstanding deposits are processed up to the maximum number
-	// of deposits.
+	// Slash the validators reported by the misbehavior evidence.
+	if err := sp.processSlashingInfos(st, blk); err != nil {
+		return err
+	}
+
+	// From Electra onwards, verify that outstanding deposits are processed
+	// up to the maximum number of deposits.
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) >= version.Electra {
+		if err := sp.verifyDepositCount(st, blk); err != nil {
+			return err
+		}
+	}
+
+	if err := sp.processDeposits(st, blk); err != nil {
+		return err
+	}
+
+	if err := sp.processDepositRequests(st, blk); err != nil {
+		return err
+	}
+
+	if err := sp.processVoluntaryExits(
+		st, blk.GetBody().GetVoluntaryExits(),
+	); err != nil {
+		return err
+	}
+
+	if err := sp.processWithdrawalRequests(st, blk.GetBody()); err != nil {
+		return err
+	}
+
+	return sp.processAttestations(st, blk.GetBody().GetAttestations())
+}
+
+// verifyDepositCount verifies that the block includes the outstanding
+// deposits of the eth1 data in the state, up to the maximum number of
+// deposits per block.
+func (sp *StateProcessor[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) verifyDepositCount(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
 	deposits := blk.GetBody().GetDeposits()
 	index, err := st.GetEth1DepositIndex()
 	if err != nil {
@@ -47,28 +92,90 @@ func (sp *StateProcessor[
 	if err != nil {
 		return err
 	}
-	depositCount := min(
-		sp.cs.MaxDepositsPerBlock(),
-		eth1Data.GetDepositCount().Unwrap()-index,
-	)
-	_ = depositCount
-	// TODO: Update eth1data count and check this.
-	// if uint64(len(deposits)) != depositCount {
-	// 	return errors.New("deposit count mismatch")
-	// }
-	return sp.processDeposits(st, deposits)
+	// Deposits may already have been processed through the deposit requests
+	// of the execution payloads, in which case none are outstanding.
+	var depositCount uint64
+	if count := eth1Data.GetDepositCount().Unwrap(); count > index {
+		depositCount = min(sp.cs.MaxDepositsPerBlock(), count-index)
+	}
+	if uint64(len(deposits)) != depositCount {
+		return errors.Wrapf(
+			ErrDepositCountMismatch, "expected %d, got %d",
+			depositCount, len(deposits),
+		)
+	}
+	return nil
+}
+
+// processEth1Data sets the eth1 data of the block on the state. As blocks
+// are final as soon as they are committed, there is no eth1 data voting and
+// the eth1 data of every block is applied directly. The deposit count of the
+// eth1 data may never decrease, and the deposit root may only change along
+// with the deposit count.
+func (sp *StateProcessor[
+	_, _, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _,
+]) processEth1Data(
+	st BeaconStateT,
+	body BeaconBlockBodyT,
+) error {
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+
+	newEth1Data := body.GetEth1Data()
+	if newEth1Data.GetDepositCount() < eth1Data.GetDepositCount() {
+		return errors.Wrapf(
+			ErrDepositCountDecreased, "expected at least %d, got %d",
+			eth1Data.GetDepositCount(), newEth1Data.GetDepositCount(),
+		)
+	}
+	if newEth1Data.GetDepositCount() == eth1Data.GetDepositCount() &&
+		eth1Data.GetDepositCount() > 0 &&
+		newEth1Data.GetDepositRoot() != eth1Data.GetDepositRoot() {
+		return errors.Wrapf(
+			ErrDepositRootChanged, "expected %s, got %s",
+			eth1Data.GetDepositRoot(), newEth1Data.GetDepositRoot(),
+		)
+	}
+	return st.SetEth1Data(newEth1Data)
 }
 
 // processDeposits processes the deposits and ensures  they match the
-// local state.
+// local state. From Electra onwards, the deposits must also be processed in
+// the order of the deposit contract and be proven against the deposit root.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
 ]) processDeposits(
 	st BeaconStateT,
-	deposits []DepositT,
+	blk BeaconBlockT,
 ) error {
+	deposits := blk.GetBody().GetDeposits()
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.Electra {
+		for _, dep := range deposits {
+			if err := sp.processDeposit(st, dep); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Every deposit must come with its Merkle branch.
+	proofs := blk.GetBody().GetDepositBranches()
+	if len(proofs) != len(deposits) {
+		return errors.Wrapf(
+			ErrInvalidDepositProof, "expected %d proofs, got %d",
+			len(deposits), len(proofs),
+		)
+	}
+
 	// Ensure the deposits match the local state.
-	for _, dep := range deposits {
+	for i, dep := range deposits {
+		if err := sp.verifyDeposit(st, dep, proofs[i]); err != nil {
+			return err
+		}
 		if err := sp.processDeposit(st, dep); err != nil {
 			return err
 		}
@@ -76,24 +183,113 @@ func (sp *StateProcessor[
 	return nil
 }
 
+// processDepositRequests processes the deposit requests of the execution
+// payload (EIP-6110), which are only accepted from Electra onwards. Deposit
+// requests share the deposit index with the deposits of the block, so the
+// requests that were already processed as deposits are skipped.
+func (sp *StateProcessor[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) processDepositRequests(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	// Execution requests are only accepted from Electra onwards.
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.Electra {
+		list, err := blk.GetBody().GetExecutionRequestsList()
+		if err != nil {
+			return err
+		}
+		if len(list) > 0 {
+			return errors.Wrapf(
+				ErrUnexpectedExecutionRequests,
+				"got %d request types", len(list),
+			)
+		}
+		return nil
+	}
+
+	for _, req := range blk.GetBody().GetDepositRequests() {
+		depositIndex, err := st.GetEth1DepositIndex()
+		if err != nil {
+			return err
+		}
+
+		// Skip the requests that were already processed as deposits.
+		if req.GetIndex().Unwrap() < depositIndex {
+			continue
+		}
+
+		// Deposits must be processed in the order of the deposit contract.
+		if req.GetIndex().Unwrap() != depositIndex {
+			return errors.Wrapf(
+				ErrDepositIndexMismatch, "expected %d, got %d",
+				depositIndex, req.GetIndex(),
+			)
+		}
+
+		if err = st.SetEth1DepositIndex(depositIndex + 1); err != nil {
+			return err
+		}
+
+		if err = sp.applyDeposit(st, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyDeposit verifies that the deposit is the next one of the deposit
+// contract and that its Merkle branch is valid against the deposit root of
+// the eth1 data.
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _,
+]) verifyDeposit(
+	st BeaconStateT,
+	dep DepositT,
+	proof []common.Root,
+) error {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+
+	// Deposits must be processed in the order of the deposit contract.
+	if dep.GetIndex().Unwrap() != depositIndex {
+		return errors.Wrapf(
+			ErrDepositIndexMismatch, "expected %d, got %d",
+			depositIndex, dep.GetIndex(),
+		)
+	}
+
+	// Verify the Merkle branch of the deposit against the deposit root.
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+	if !merkle.IsValidMerkleBranch(
+		dep.GetDepositDataRoot(),
+		proof,
+		constants.DepositProofLength,
+		depositIndex,
+		eth1Data.GetDepositRoot(),
+	) {
+		return errors.Wrapf(
+			ErrInvalidDepositProof, "deposit index %d", depositIndex,
+		)
+	}
+	return nil
+}
+
 // processDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _,
 ]) processDeposit(
 	st BeaconStateT,
 	dep DepositT,
 ) error {
-	// TODO: fill this in properly
-	// if !sp.isValidMerkleBranch(
-	// 	leaf,
-	// 	dep.Credentials,
-	// 	32 + 1,
-	// 	dep.Index,
-	// 	st.root,
-	// ) {
-	// 	return errors.New("invalid merkle branch")
-	// }
-
 	depositIndex, err := st.GetEth1DepositIndex()
 	if err != nil {
 		return err
@@ -110,24 +306,15 @@ func (sp *StateProcessor[
 
 // applyDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, ValidatorT, _,
+	_, _, _, _, _,
 ]) applyDeposit(
 	st BeaconStateT,
 	dep DepositT,
 ) error {
 	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
-	// If the validator already exists, we update the balance.
 	if err == nil {
-		var val ValidatorT
-		val, err = st.ValidatorByIndex(idx)
-		if err != nil {
-			return err
-		}
-
-		// TODO: Modify balance here and then effective balance once per epoch.
-		val.SetEffectiveBalance(min(val.GetEffectiveBalance()+dep.GetAmount(),
-			math.Gwei(sp.cs.MaxEffectiveBalance())))
-		return st.UpdateValidatorAtIndex(idx, val)
+		return sp.topUpValidator(st, idx, dep.GetAmount())
 	}
 
 	// If the validator does not exist, we add the validator.
@@ -135,9 +322,40 @@ func (sp *StateProcessor[
 	return sp.createValidator(st, dep)
 }
 
+// topUpValidator tops up the balance of an existing validator. From Electra
+// onwards, the effective balance catches up at the next epoch boundary,
+// while before, the effective balance is raised directly.
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+	_, _, _,
+]) topUpValidator(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	amount math.Gwei,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if sp.cs.ActiveForkVersionForSlot(slot) >= version.Electra {
+		return st.IncreaseBalance(idx, amount)
+	}
+
+	var val ValidatorT
+	if val, err = st.ValidatorByIndex(idx); err != nil {
+		return err
+	}
+	val.SetEffectiveBalance(min(
+		val.GetEffectiveBalance()+amount,
+		math.Gwei(sp.cs.MaxEffectiveBalance()),
+	))
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
 // createValidator creates a validator if the deposit is valid.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _,
+	_, _, _, _,
 ]) createValidator(
 	st BeaconStateT,
 	dep DepositT,
@@ -188,7 +406,8 @@ func (sp *StateProcessor[
 
 // addValidatorToRegistry adds a validator to the registry.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, ValidatorT, _,
+	_, _, _, _, _,
 ]) addValidatorToRegistry(
 	st BeaconStateT,
 	dep DepositT,
@@ -202,13 +421,27 @@ func (sp *StateProcessor[
 		math.Gwei(sp.cs.MaxEffectiveBalance()),
 	)
 
+	// From Electra onwards, genesis validators are active from genesis. Any
+	// later validator goes through the activation queue in
+	// processRegistryUpdates. Validators added before Electra are activated
+	// at the fork.
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if slot == 0 &&
+		sp.cs.ActiveForkVersionForSlot(slot) >= version.Electra {
+		val.SetActivationEligibilityEpoch(math.Epoch(constants.GenesisEpoch))
+		val.SetActivationEpoch(math.Epoch(constants.GenesisEpoch))
+	}
+
 	// TODO: This is a bug that lives on bArtio. Delete this eventually.
 	const bArtioChainID = 80084
 	if sp.cs.DepositEth1ChainID() == bArtioChainID {
-		if err := st.AddValidatorBartio(val); err != nil {
+		if err = st.AddValidatorBartio(val); err != nil {
 			return err
 		}
-	} else if err := st.AddValidator(val); err != nil {
+	} else if err = st.AddValidator(val); err != nil {
 		return err
 	}
 
@@ -225,7 +458,8 @@ func (sp *StateProcessor[
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _,
 ]) processWithdrawals(
 	st BeaconStateT,
 	body BeaconBlockBodyT,
@@ -238,7 +472,8 @@ func (sp *StateProcessor[
 	)
 
 	// Get the expected withdrawals.
-	expectedWithdrawals, err := st.ExpectedWithdrawals()
+	expectedWithdrawals, processedPartials, err :=
+		st.ExpectedWithdrawalsAndPartialsCount()
 	if err != nil {
 		return err
 	}
@@ -270,6 +505,12 @@ func (sp *StateProcessor[
 		}
 	}
 
+	// Remove the pending partial withdrawals that were processed, including
+	// those that were skipped.
+	if err = st.DequeuePendingPartialWithdrawals(processedPartials); err != nil {
+		return err
+	}
+
 	// Update the next withdrawal index if this block contained withdrawals
 	if numWithdrawals != 0 {
 		// Next sweep starts after the latest withdrawal's validator index
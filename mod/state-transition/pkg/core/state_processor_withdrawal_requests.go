@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"bytes"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// processWithdrawalRequests processes the withdrawal requests of the
+// execution payload (EIP-7002). Requests are only present from Electra
+// onwards, which is enforced by processDepositRequests.
+func (sp *StateProcessor[
+	_, _, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _,
+]) processWithdrawalRequests(
+	st BeaconStateT,
+	body BeaconBlockBodyT,
+) error {
+	for _, req := range body.GetWithdrawalRequests() {
+		if err := sp.processWithdrawalRequest(st, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processWithdrawalRequest as defined in the Electra specification. Requests
+// are sent by the execution layer without validation, so invalid requests
+// are ignored rather than invalidating the block.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_withdrawal_request
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	WithdrawalRequestT, _, _, _,
+]) processWithdrawalRequest(
+	st BeaconStateT,
+	req WithdrawalRequestT,
+) error {
+	isFullExit := req.GetAmount().Unwrap() == constants.FullExitRequestAmount
+
+	idx, err := st.ValidatorIndexByPubkey(req.GetValidatorPubkey())
+	if err != nil {
+		//nolint:nilerr // unknown validators are ignored.
+		return nil
+	}
+
+	// Count the pending partial withdrawals of the queue and those of the
+	// validator in a single pass.
+	var queueLength uint64
+	var pendingBalance math.Gwei
+	if err = st.WalkPendingPartialWithdrawals(
+		func(i math.ValidatorIndex, amount math.Gwei, _ math.Epoch) (
+			bool, error,
+		) {
+			queueLength++
+			if i == idx {
+				pendingBalance += amount
+			}
+			return false, nil
+		},
+	); err != nil {
+		return err
+	}
+
+	// Only full exits are processed once the partial withdrawals queue is
+	// full.
+	if queueLength >= constants.PendingPartialWithdrawalsLimit && !isFullExit {
+		return nil
+	}
+
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	// The request must be sent by the execution address of the validator's
+	// withdrawal credentials.
+	creds := val.GetWithdrawalCredentials()
+	sourceAddress := req.GetSourceAddress()
+	switch {
+	case !val.HasEth1WithdrawalCredentials(),
+		!bytes.Equal(creds[12:], sourceAddress[:]):
+		return nil
+	case !val.IsActive(epoch),
+		val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch),
+		epoch < val.GetActivationEpoch()+
+			math.Epoch(sp.cs.ShardCommitteePeriod()):
+		return nil
+	}
+
+	// A full exit is only initiated once the validator has no pending
+	// partial withdrawals.
+	if isFullExit {
+		if pendingBalance == 0 {
+			return sp.initiateValidatorExit(st, idx)
+		}
+		return nil
+	}
+
+	balance, err := st.GetBalance(idx)
+	if err != nil {
+		return err
+	}
+
+	// Only the balance above the maximum effective balance that is not
+	// already pending withdrawal may be withdrawn.
+	maxEffectiveBalance := math.Gwei(sp.cs.MaxEffectiveBalance())
+	if val.GetEffectiveBalance() < maxEffectiveBalance ||
+		balance <= maxEffectiveBalance+pendingBalance {
+		return nil
+	}
+
+	return st.AddPendingPartialWithdrawal(
+		idx,
+		min(balance-maxEffectiveBalance-pendingBalance, req.GetAmount()),
+		sp.computeActivationExitEpoch(epoch)+
+			math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+	)
+}
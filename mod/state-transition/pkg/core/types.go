@@ -33,17 +33,53 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// AttestationData is the interface for an attestation included in a beacon
+// block body.
+type AttestationData interface {
+	// GetSlot returns the slot the attestation was made for.
+	GetSlot() math.Slot
+	// GetIndex returns the index of the attesting validator.
+	GetIndex() math.U64
+}
+
+// SlashingInfo is the interface for the evidence of a validator
+// misbehavior included in a beacon block body.
+type SlashingInfo interface {
+	// GetSlot returns the slot at which the misbehavior occurred.
+	GetSlot() math.Slot
+	// GetIndex returns the index of the misbehaving validator.
+	GetIndex() math.U64
+}
+
+// WithdrawalRequest is the interface for a withdrawal request triggered by
+// an execution payload, as per EIP-7002.
+type WithdrawalRequest interface {
+	// GetSourceAddress returns the execution address that sent the request.
+	GetSourceAddress() common.ExecutionAddress
+	// GetValidatorPubkey returns the public key of the validator to withdraw
+	// from.
+	GetValidatorPubkey() crypto.BLSPubkey
+	// GetAmount returns the amount to withdraw, zero requesting a full exit.
+	GetAmount() math.Gwei
+}
+
 // BeaconBlock represents a generic interface for a beacon block.
 type BeaconBlock[
+	AttestationDataT any,
 	DepositT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		BeaconBlockBodyT, AttestationDataT, DepositT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, SlashingInfoT,
+		VoluntaryExitT, WithdrawalRequestT, WithdrawalsT,
 	],
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	SlashingInfoT any,
+	VoluntaryExitT any,
+	WithdrawalRequestT any,
 	WithdrawalsT any,
 ] interface {
 	IsNil() bool
@@ -63,20 +99,44 @@ type BeaconBlock[
 // block.
 type BeaconBlockBody[
 	BeaconBlockBodyT any,
+	AttestationDataT any,
 	DepositT any,
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	SlashingInfoT any,
+	VoluntaryExitT any,
+	WithdrawalRequestT any,
 	WithdrawalsT any,
 ] interface {
 	constraints.EmptyWithVersion[BeaconBlockBodyT]
 	// GetRandaoReveal returns the RANDAO reveal signature.
 	GetRandaoReveal() crypto.BLSSignature
+	// GetEth1Data returns the eth1 data.
+	GetEth1Data() Eth1DataT
 	// GetExecutionPayload returns the execution payload.
 	GetExecutionPayload() ExecutionPayloadT
 	// GetDeposits returns the list of deposits.
 	GetDeposits() []DepositT
+	// GetDepositBranches returns the Merkle branches of the deposits.
+	GetDepositBranches() [][]common.Root
+	// GetDepositRequests returns the deposits requested by the execution
+	// payload.
+	GetDepositRequests() []DepositT
+	// GetWithdrawalRequests returns the withdrawal requests triggered by the
+	// execution payload.
+	GetWithdrawalRequests() []WithdrawalRequestT
+	// GetExecutionRequestsList returns the execution requests of the
+	// execution payload, as per EIP-7685.
+	GetExecutionRequestsList() ([]bytes.Bytes, error)
+	// GetVoluntaryExits returns the list of voluntary exits.
+	GetVoluntaryExits() []VoluntaryExitT
+	// GetAttestations returns the list of attestations.
+	GetAttestations() []AttestationDataT
+	// GetSlashingInfo returns the list of slashing info.
+	GetSlashingInfo() []SlashingInfoT
 	// HashTreeRoot returns the hash tree root of the block body.
 	HashTreeRoot() common.Root
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
@@ -131,6 +191,11 @@ type Deposit[
 	GetPubkey() crypto.BLSPubkey
 	// GetWithdrawalCredentials returns the withdrawal credentials.
 	GetWithdrawalCredentials() WithdrawlCredentialsT
+	// GetIndex returns the index of the deposit in the deposit contract.
+	GetIndex() math.U64
+	// GetDepositDataRoot returns the leaf of the deposit in the deposit
+	// contract Merkle tree.
+	GetDepositDataRoot() common.Root
 	// VerifySignature verifies the deposit and creates a validator.
 	VerifySignature(
 		forkData ForkDataT,
@@ -220,6 +285,11 @@ type Validator[
 	) ValidatorT
 	// IsSlashed returns true if the validator is slashed.
 	IsSlashed() bool
+	// SetSlashed sets whether the validator is slashed.
+	SetSlashed(bool)
+	// IsSlashable returns true if the validator can be slashed at the given
+	// epoch.
+	IsSlashable(epoch math.Epoch) bool
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetEffectiveBalance returns the effective balance of the validator in
@@ -227,14 +297,57 @@ type Validator[
 	GetEffectiveBalance() math.Gwei
 	// SetEffectiveBalance sets the effective balance of the validator in Gwei.
 	SetEffectiveBalance(math.Gwei)
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+	// HasEth1WithdrawalCredentials returns true if the withdrawal credentials
+	// of the validator are an execution address.
+	HasEth1WithdrawalCredentials() bool
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
+	// IsActive returns true if the validator is active at the given epoch.
+	IsActive(epoch math.Epoch) bool
+	// GetActivationEpoch returns the epoch in which the validator activated.
+	GetActivationEpoch() math.Epoch
+	// GetActivationEligibilityEpoch returns the epoch in which the validator
+	// became eligible for activation.
+	GetActivationEligibilityEpoch() math.Epoch
+	// SetActivationEligibilityEpoch sets the epoch in which the validator
+	// became eligible for activation.
+	SetActivationEligibilityEpoch(math.Epoch)
+	// SetActivationEpoch sets the epoch in which the validator activated.
+	SetActivationEpoch(math.Epoch)
+	// GetExitEpoch returns the epoch in which the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch in which the validator exits.
+	SetExitEpoch(math.Epoch)
 }
 
 type Validators interface {
 	HashTreeRoot() common.Root
 }
 
+// VoluntaryExit is the interface for a signed voluntary exit.
+type VoluntaryExit[ForkDataT any] interface {
+	// GetEpoch returns the earliest epoch at which the exit may be processed.
+	GetEpoch() math.Epoch
+	// GetValidatorIndex returns the index of the exiting validator.
+	GetValidatorIndex() math.ValidatorIndex
+	// VerifySignature verifies that the exit was signed by the given public
+	// key.
+	VerifySignature(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		pubkey crypto.BLSPubkey,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte, signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
 // Withdrawal is the interface for a withdrawal.
 type Withdrawal[WithdrawalT any] interface {
 	// Equals returns true if the withdrawal is equal to the other.
@@ -44,31 +44,39 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	EpochParticipationPrefix
+	InactivityScoresPrefix
+	PendingPartialWithdrawalsPrefix
+	PendingPartialWithdrawalsSequencePrefix
 )
 
 //nolint:lll
 const (
-	WithdrawalQueuePrefixHumanReadable                  = "WithdrawalQueuePrefix"
-	RandaoMixPrefixHumanReadable                        = "RandaoMixPrefix"
-	SlashingsPrefixHumanReadable                        = "SlashingsPrefix"
-	TotalSlashingPrefixHumanReadable                    = "TotalSlashingPrefix"
-	ValidatorIndexPrefixHumanReadable                   = "ValidatorIndexPrefix"
-	BlockRootsPrefixHumanReadable                       = "BlockRootsPrefix"
-	StateRootsPrefixHumanReadable                       = "StateRootsPrefix"
-	ValidatorByIndexPrefixHumanReadable                 = "ValidatorByIndexPrefix"
-	ValidatorPubkeyToIndexPrefixHumanReadable           = "ValidatorPubkeyToIndexPrefix"
-	ValidatorConsAddrToIndexPrefixHumanReadable         = "ValidatorConsAddrToIndexPrefix"
-	ValidatorEffectiveBalanceToIndexPrefixHumanReadable = "ValidatorEffectiveBalanceToIndexPrefix"
-	LatestBeaconBlockHeaderPrefixHumanReadable          = "LatestBeaconBlockHeaderPrefix"
-	SlotPrefixHumanReadable                             = "SlotPrefix"
-	BalancesPrefixHumanReadable                         = "BalancesPrefix"
-	Eth1BlockHashPrefixHumanReadable                    = "Eth1BlockHashPrefix"
-	Eth1DataPrefixHumanReadable                         = "Eth1DataPrefix"
-	Eth1DepositIndexPrefixHumanReadable                 = "Eth1DepositIndexPrefix"
-	LatestExecutionPayloadHeaderPrefixHumanReadable     = "LatestExecutionPayloadHeaderPrefix"
-	LatestExecutionPayloadVersionPrefixHumanReadable    = "LatestExecutionPayloadVersionPrefix"
-	GenesisValidatorsRootPrefixHumanReadable            = "GenesisValidatorsRootPrefix"
-	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
-	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
-	ForkPrefixHumanReadable                             = "ForkPrefix"
+	WithdrawalQueuePrefixHumanReadable                   = "WithdrawalQueuePrefix"
+	RandaoMixPrefixHumanReadable                         = "RandaoMixPrefix"
+	SlashingsPrefixHumanReadable                         = "SlashingsPrefix"
+	TotalSlashingPrefixHumanReadable                     = "TotalSlashingPrefix"
+	ValidatorIndexPrefixHumanReadable                    = "ValidatorIndexPrefix"
+	BlockRootsPrefixHumanReadable                        = "BlockRootsPrefix"
+	StateRootsPrefixHumanReadable                        = "StateRootsPrefix"
+	ValidatorByIndexPrefixHumanReadable                  = "ValidatorByIndexPrefix"
+	ValidatorPubkeyToIndexPrefixHumanReadable            = "ValidatorPubkeyToIndexPrefix"
+	ValidatorConsAddrToIndexPrefixHumanReadable          = "ValidatorConsAddrToIndexPrefix"
+	ValidatorEffectiveBalanceToIndexPrefixHumanReadable  = "ValidatorEffectiveBalanceToIndexPrefix"
+	LatestBeaconBlockHeaderPrefixHumanReadable           = "LatestBeaconBlockHeaderPrefix"
+	SlotPrefixHumanReadable                              = "SlotPrefix"
+	BalancesPrefixHumanReadable                          = "BalancesPrefix"
+	Eth1BlockHashPrefixHumanReadable                     = "Eth1BlockHashPrefix"
+	Eth1DataPrefixHumanReadable                          = "Eth1DataPrefix"
+	Eth1DepositIndexPrefixHumanReadable                  = "Eth1DepositIndexPrefix"
+	LatestExecutionPayloadHeaderPrefixHumanReadable      = "LatestExecutionPayloadHeaderPrefix"
+	LatestExecutionPayloadVersionPrefixHumanReadable     = "LatestExecutionPayloadVersionPrefix"
+	GenesisValidatorsRootPrefixHumanReadable             = "GenesisValidatorsRootPrefix"
+	NextWithdrawalIndexPrefixHumanReadable               = "NextWithdrawalIndexPrefix"
+	NextWithdrawalValidatorIndexPrefixHumanReadable      = "NextWithdrawalValidatorIndexPrefix"
+	ForkPrefixHumanReadable                              = "ForkPrefix"
+	EpochParticipationPrefixHumanReadable                = "EpochParticipationPrefix"
+	InactivityScoresPrefixHumanReadable                  = "InactivityScoresPrefix"
+	PendingPartialWithdrawalsPrefixHumanReadable         = "PendingPartialWithdrawalsPrefix"
+	PendingPartialWithdrawalsSequencePrefixHumanReadable = "PendingPartialWithdrawalsSequencePrefix"
 )
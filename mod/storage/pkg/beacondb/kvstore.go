@@ -106,6 +106,22 @@ type KVStore[
 	slashings sdkcollections.Map[uint64, uint64]
 	// totalSlashing stores the total slashing in the vector range.
 	totalSlashing sdkcollections.Item[uint64]
+	// Participation
+	// epochParticipation stores the number of attestations included for each
+	// validator in the current epoch.
+	epochParticipation sdkcollections.Map[uint64, uint64]
+	// inactivityScores stores the inactivity score of each validator.
+	inactivityScores sdkcollections.Map[uint64, uint64]
+	// Withdrawal requests
+	// pendingPartialWithdrawalsSequence provides the queue position of the
+	// next pending partial withdrawal.
+	pendingPartialWithdrawalsSequence sdkcollections.Sequence
+	// pendingPartialWithdrawals stores the amount of the pending partial
+	// withdrawals, keyed by their queue position, validator index and
+	// withdrawable epoch.
+	pendingPartialWithdrawals sdkcollections.Map[
+		sdkcollections.Triple[uint64, uint64, uint64], uint64,
+	]
 }
 
 // New creates a new instance of Store.
@@ -259,6 +275,40 @@ func New[
 			keys.TotalSlashingPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		epochParticipation: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.EpochParticipationPrefix}),
+			keys.EpochParticipationPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		inactivityScores: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.InactivityScoresPrefix}),
+			keys.InactivityScoresPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		pendingPartialWithdrawalsSequence: sdkcollections.NewSequence(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.PendingPartialWithdrawalsSequencePrefix},
+			),
+			keys.PendingPartialWithdrawalsSequencePrefixHumanReadable,
+		),
+		pendingPartialWithdrawals: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.PendingPartialWithdrawalsPrefix},
+			),
+			keys.PendingPartialWithdrawalsPrefixHumanReadable,
+			sdkcollections.TripleKeyCodec(
+				sdkcollections.Uint64Key,
+				sdkcollections.Uint64Key,
+				sdkcollections.Uint64Key,
+			),
+			sdkcollections.Uint64Value,
+		),
 		latestBlockHeader: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
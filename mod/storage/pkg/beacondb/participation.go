@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetEpochParticipation retrieves the number of attestations included for the
+// validator in the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetEpochParticipation(
+	idx math.ValidatorIndex,
+) (uint64, error) {
+	count, err := kv.epochParticipation.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return count, err
+}
+
+// SetEpochParticipation sets the number of attestations included for the
+// validator in the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetEpochParticipation(
+	idx math.ValidatorIndex,
+	count uint64,
+) error {
+	return kv.epochParticipation.Set(kv.ctx, idx.Unwrap(), count)
+}
+
+// ResetEpochParticipation clears the participation of all validators.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) ResetEpochParticipation() error {
+	return kv.epochParticipation.Clear(kv.ctx, nil)
+}
+
+// GetInactivityScore retrieves the inactivity score of the validator.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetInactivityScore(
+	idx math.ValidatorIndex,
+) (uint64, error) {
+	score, err := kv.inactivityScores.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return score, err
+}
+
+// SetInactivityScore sets the inactivity score of the validator.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetInactivityScore(
+	idx math.ValidatorIndex,
+	score uint64,
+) error {
+	return kv.inactivityScores.Set(kv.ctx, idx.Unwrap(), score)
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"context"
+	"strconv"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+)
+
+// Routes of the ABCI queries against the beacon state, following the route
+// under which QueryKey is registered.
+const (
+	// QueryValidator is the route of a validator by public key, i.e.
+	// validator/{pubkey}.
+	QueryValidator = "validator"
+	// QueryBalance is the route of a balance by validator index, i.e.
+	// balance/{index}.
+	QueryBalance = "balance"
+	// QuerySlot is the route of the current slot.
+	QuerySlot = "slot"
+	// QueryLatestExecutionPayloadHeader is the route of the latest execution
+	// payload header.
+	QueryLatestExecutionPayloadHeader = "latest_execution_payload_header"
+)
+
+// ErrUnknownQuery is returned when the path of a query does not match any of
+// the beacon state routes.
+var ErrUnknownQuery = errors.New("unknown beacon state query")
+
+// QueryKey resolves the path of an ABCI query against the beacon state read
+// from ctx to the key under which the queried value is stored. The value is
+// served as stored, so that it can be checked against the proof of the key:
+// validators and execution payload headers are SSZ encoded, while balances
+// and the slot are big-endian uint64s.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) QueryKey(
+	ctx context.Context,
+	path []string,
+) ([]byte, error) {
+	switch {
+	case len(path) == 2 && path[0] == QueryValidator:
+		var pubkey crypto.BLSPubkey
+		if err := pubkey.UnmarshalText([]byte(path[1])); err != nil {
+			return nil, errors.Wrapf(err, "invalid pubkey %s", path[1])
+		}
+		idx, err := kv.WithContext(ctx).ValidatorIndexByPubkey(pubkey)
+		if err != nil {
+			return nil, err
+		}
+		return sdkcollections.EncodeKeyWithPrefix(
+			[]byte{keys.ValidatorByIndexPrefix},
+			kv.validators.KeyCodec(),
+			idx.Unwrap(),
+		)
+	case len(path) == 2 && path[0] == QueryBalance:
+		idx, err := strconv.ParseUint(path[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid validator index %s", path[1])
+		}
+		return sdkcollections.EncodeKeyWithPrefix(
+			kv.balances.GetPrefix(), kv.balances.KeyCodec(), idx,
+		)
+	case len(path) == 1 && path[0] == QuerySlot:
+		return []byte{keys.SlotPrefix}, nil
+	case len(path) == 1 && path[0] == QueryLatestExecutionPayloadHeader:
+		return []byte{keys.LatestExecutionPayloadHeaderPrefix}, nil
+	default:
+		return nil, errors.Wrapf(ErrUnknownQuery, "path: %v", path)
+	}
+}
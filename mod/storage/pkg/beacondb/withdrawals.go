@@ -20,7 +20,10 @@
 
 package beacondb
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+import (
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
 
 // GetNextWithdrawalIndex returns the next withdrawal index.
 func (kv *KVStore[
@@ -60,3 +63,84 @@ func (kv *KVStore[
 ) error {
 	return kv.nextWithdrawalValidatorIndex.Set(kv.ctx, index.Unwrap())
 }
+
+// AddPendingPartialWithdrawal appends a pending partial withdrawal of the
+// given amount for the validator to the queue.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) AddPendingPartialWithdrawal(
+	idx math.ValidatorIndex,
+	amount math.Gwei,
+	withdrawableEpoch math.Epoch,
+) error {
+	position, err := kv.pendingPartialWithdrawalsSequence.Next(kv.ctx)
+	if err != nil {
+		return err
+	}
+	return kv.pendingPartialWithdrawals.Set(
+		kv.ctx,
+		sdkcollections.Join3(
+			position, idx.Unwrap(), withdrawableEpoch.Unwrap(),
+		),
+		amount.Unwrap(),
+	)
+}
+
+// WalkPendingPartialWithdrawals calls fn on the pending partial withdrawals
+// in the order of the queue, until fn returns true or an error.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) WalkPendingPartialWithdrawals(
+	fn func(
+		idx math.ValidatorIndex,
+		amount math.Gwei,
+		withdrawableEpoch math.Epoch,
+	) (bool, error),
+) error {
+	return kv.pendingPartialWithdrawals.Walk(
+		kv.ctx, nil,
+		func(
+			key sdkcollections.Triple[uint64, uint64, uint64],
+			amount uint64,
+		) (bool, error) {
+			return fn(
+				math.ValidatorIndex(key.K2()),
+				math.Gwei(amount),
+				math.Epoch(key.K3()),
+			)
+		},
+	)
+}
+
+// DequeuePendingPartialWithdrawals removes the given number of pending
+// partial withdrawals from the front of the queue.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) DequeuePendingPartialWithdrawals(count uint64) error {
+	if count == 0 {
+		return nil
+	}
+
+	keys := make([]sdkcollections.Triple[uint64, uint64, uint64], 0, count)
+	if err := kv.pendingPartialWithdrawals.Walk(
+		kv.ctx, nil,
+		func(
+			key sdkcollections.Triple[uint64, uint64, uint64], _ uint64,
+		) (bool, error) {
+			keys = append(keys, key)
+			return uint64(len(keys)) == count, nil
+		},
+	); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := kv.pendingPartialWithdrawals.Remove(kv.ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -24,10 +24,12 @@ const (
 	BlockKeyPrefix byte = iota
 	RootsKeyPrefix
 	ExecutionNumbersKeyPrefix
+	VersionsKeyPrefix
 )
 
 const (
 	BlocksMapName           = "blocks"
 	RootsMapName            = "roots"
 	ExecutionNumbersMapName = "execution_numbers"
+	VersionsMapName         = "versions"
 )
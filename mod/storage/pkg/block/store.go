@@ -33,29 +33,42 @@ import (
 )
 
 // KVStore is a simple KV store based implementation that stores beacon blocks.
+//
+// Blocks are kept in their SSZ encoding alongside the fork version they were
+// encoded with, so that they can be decoded regardless of the active fork.
+// Blocks stored before fork versions were recorded are decoded with the fork
+// version active at their slot.
 type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
-	blocks           sdkcollections.Map[math.Slot, BeaconBlockT]
+	blocks           sdkcollections.Map[math.Slot, []byte]
+	versions         sdkcollections.Map[math.Slot, uint32]
 	roots            sdkcollections.Map[[]byte, math.Slot]
 	executionNumbers sdkcollections.Map[math.U64, math.Slot]
+	cs               ChainSpec
 
 	mu           sync.RWMutex
-	cdc          *encoding.SSZInterfaceCodec[BeaconBlockT]
 	earliestSlot math.Slot
 }
 
 // NewStore creates a new block store.
 func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
 	kvsp store.KVStoreService,
+	cs ChainSpec,
 ) *KVStore[BeaconBlockT] {
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
-	cdc := &encoding.SSZInterfaceCodec[BeaconBlockT]{}
 	return &KVStore[BeaconBlockT]{
 		blocks: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{BlockKeyPrefix}),
 			BlocksMapName,
 			encoding.U64Key,
-			cdc,
+			sdkcollections.BytesValue,
+		),
+		versions: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{VersionsKeyPrefix}),
+			VersionsMapName,
+			encoding.U64Key,
+			sdkcollections.Uint32Value,
 		),
 		roots: sdkcollections.NewMap(
 			schemaBuilder,
@@ -71,7 +84,7 @@ func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
 			encoding.U64Key,
 			encoding.U64Value,
 		),
-		cdc: cdc,
+		cs: cs,
 	}
 }
 
@@ -80,7 +93,7 @@ func (kv *KVStore[BeaconBlockT]) Get(slot math.Slot) (BeaconBlockT, error) {
 	kv.mu.RLock()
 	defer kv.mu.RUnlock()
 
-	return kv.blocks.Get(context.TODO(), slot)
+	return kv.get(context.TODO(), slot)
 }
 
 // Set sets the block by a given index in the store and also stores the
@@ -107,9 +120,17 @@ func (kv *KVStore[BeaconBlockT]) Set(slot math.Slot, blk BeaconBlockT) error {
 		return err
 	}
 
+	// Set the fork version of the block in the versions map.
+	if err = kv.versions.Set(ctx, slot, blk.Version()); err != nil {
+		return err
+	}
+
 	// Set the block in the blocks map.
-	kv.cdc.SetActiveForkVersion(blk.Version())
-	return kv.blocks.Set(ctx, slot, blk)
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return kv.blocks.Set(ctx, slot, bz)
 }
 
 // GetSlotByRoot retrieves the slot by a given root from the store.
@@ -146,7 +167,7 @@ func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
 	// We only return early from this loop with an error if the key
 	// passed in cannot be encoded.
 	for i := max(s, kv.earliestSlot); i < e; i++ {
-		block, err := kv.blocks.Get(ctx, i)
+		block, err := kv.get(ctx, i)
 		if !errors.Is(err, sdkcollections.ErrNotFound) {
 			// If block is found and still errors, exit and return.
 			if err != nil {
@@ -167,7 +188,10 @@ func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
 			}
 		}
 
-		// Finally remove the block from the blocks map.
+		// Finally remove the block from the versions and blocks maps.
+		if err = kv.versions.Remove(ctx, i); err != nil {
+			return err
+		}
 		if err = kv.blocks.Remove(ctx, i); err != nil {
 			return err
 		}
@@ -176,3 +200,24 @@ func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
 	kv.earliestSlot = e
 	return nil
 }
+
+// get decodes the block at the given slot using the fork version it was
+// stored with. The caller must hold the lock.
+func (kv *KVStore[BeaconBlockT]) get(
+	ctx context.Context,
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	forkVersion, err := kv.versions.Get(ctx, slot)
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		forkVersion = kv.cs.ActiveForkVersionForSlot(slot)
+	case err != nil:
+		return blk, err
+	}
+	bz, err := kv.blocks.Get(ctx, slot)
+	if err != nil {
+		return blk, err
+	}
+	return blk.NewFromSSZ(bz, forkVersion)
+}
@@ -33,3 +33,10 @@ type BeaconBlock[T any] interface {
 	HashTreeRoot() common.Root
 	GetExecutionNumber() math.U64
 }
+
+// ChainSpec is the chain specification used by the block store.
+type ChainSpec interface {
+	// ActiveForkVersionForSlot returns the active fork version for a given
+	// slot.
+	ActiveForkVersionForSlot(slot math.Slot) uint32
+}
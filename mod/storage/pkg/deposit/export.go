@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// ErrInvalidEntry is returned when an exported entry of the deposit store
+// cannot be decoded.
+var ErrInvalidEntry = errors.New("invalid deposit store entry")
+
+// Export writes every entry of the deposit store, such that the store can be
+// rebuilt from them with Import.
+func (kv *KVStore[DepositT]) Export(write func([]byte) error) error {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.kvsp.OpenKVStore(context.TODO()).Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err = write(encodeEntry(iter.Key(), iter.Value())); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// Import replaces the contents of the deposit store with the entries read
+// until io.EOF, as written by Export.
+func (kv *KVStore[DepositT]) Import(read func() ([]byte, error)) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	kvs := kv.kvsp.OpenKVStore(context.TODO())
+
+	// The keys are collected first, as the store must not be written to
+	// while it is being iterated over.
+	iter, err := kvs.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, bytes.Clone(iter.Key()))
+	}
+	if err = errors.Join(iter.Error(), iter.Close()); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err = kvs.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	for {
+		bz, err := read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		key, value, err := decodeEntry(bz)
+		if err != nil {
+			return err
+		}
+		if err = kvs.Set(key, value); err != nil {
+			return err
+		}
+	}
+}
+
+// encodeEntry encodes a key-value pair as the length of the key, followed by
+// the key and the value.
+func encodeEntry(key, value []byte) []byte {
+	bz := make([]byte, 0, binary.MaxVarintLen64+len(key)+len(value))
+	bz = binary.AppendUvarint(bz, uint64(len(key)))
+	bz = append(bz, key...)
+	return append(bz, value...)
+}
+
+// decodeEntry decodes a key-value pair encoded by encodeEntry.
+func decodeEntry(bz []byte) ([]byte, []byte, error) {
+	keyLen, n := binary.Uvarint(bz)
+	if n <= 0 || keyLen > uint64(len(bz)-n) {
+		return nil, nil, ErrInvalidEntry
+	}
+	bz = bz[n:]
+	return bz[:keyLen], bz[keyLen:], nil
+}
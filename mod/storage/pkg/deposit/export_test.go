@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		key, value []byte
+	}{
+		{key: []byte("deposit"), value: []byte{1, 2, 3}},
+		{key: []byte("last_scanned_block"), value: []byte{}},
+		{key: make([]byte, 300), value: []byte{4}},
+	} {
+		key, value, err := decodeEntry(encodeEntry(tc.key, tc.value))
+		require.NoError(t, err)
+		require.Equal(t, tc.key, key)
+		require.Equal(t, tc.value, value)
+	}
+}
+
+func TestDecodeEntry_Invalid(t *testing.T) {
+	for _, bz := range [][]byte{
+		nil,
+		{0x80},
+		encodeEntry([]byte("deposit"), nil)[:4],
+	} {
+		_, _, err := decodeEntry(bz)
+		require.ErrorIs(t, err, ErrInvalidEntry)
+	}
+}
@@ -30,13 +30,25 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix          = "deposit"
+	KeyTreeSnapshotPrefix     = "tree_snapshot"
+	KeyLastScannedBlockPrefix = "last_scanned_block"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit[DepositT]] struct {
+	// kvsp provides the underlying KV store, whose entries are exported
+	// as a whole.
+	kvsp  store.KVStoreService
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// treeSnapshot holds the encoded snapshot of the deposit Merkle tree.
+	treeSnapshot sdkcollections.Item[[]byte]
+	// lastScannedBlock holds the number of the last execution block whose
+	// deposit logs have been scanned.
+	lastScannedBlock sdkcollections.Item[uint64]
+	mu               sync.RWMutex
 }
 
 // NewStore creates a new deposit store.
@@ -45,6 +57,7 @@ func NewStore[DepositT Deposit[DepositT]](
 ) *KVStore[DepositT] {
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
 	return &KVStore[DepositT]{
+		kvsp: kvsp,
 		store: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte(KeyDepositPrefix)),
@@ -52,6 +65,18 @@ func NewStore[DepositT Deposit[DepositT]](
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		treeSnapshot: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyTreeSnapshotPrefix)),
+			KeyTreeSnapshotPrefix,
+			sdkcollections.BytesValue,
+		),
+		lastScannedBlock: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyLastScannedBlockPrefix)),
+			KeyLastScannedBlockPrefix,
+			sdkcollections.Uint64Value,
+		),
 	}
 }
 
@@ -114,3 +139,42 @@ func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	}
 	return nil
 }
+
+// GetTreeSnapshot returns the persisted snapshot of the deposit Merkle tree,
+// or nil if no snapshot has been persisted yet.
+func (kv *KVStore[DepositT]) GetTreeSnapshot() ([]byte, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	bz, err := kv.treeSnapshot.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, nil
+	}
+	return bz, err
+}
+
+// SetTreeSnapshot persists the snapshot of the deposit Merkle tree.
+func (kv *KVStore[DepositT]) SetTreeSnapshot(snapshot []byte) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.treeSnapshot.Set(context.TODO(), snapshot)
+}
+
+// GetLastScannedBlock returns the number of the last execution block whose
+// deposit logs have been scanned, or 0 if no block has been scanned yet.
+func (kv *KVStore[DepositT]) GetLastScannedBlock() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	number, err := kv.lastScannedBlock.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return number, err
+}
+
+// SetLastScannedBlock persists the number of the last execution block whose
+// deposit logs have been scanned.
+func (kv *KVStore[DepositT]) SetLastScannedBlock(number uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.lastScannedBlock.Set(context.TODO(), number)
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// VoluntaryExitPool holds the voluntary exits received by the node that have
+// not yet been included in a block. At most one exit is kept per validator.
+//
+// The pool is local to the node and its exits are not gossiped to other
+// nodes, so an exit is only included once this node proposes a block.
+// Exits must therefore be submitted to the node of the validator that is
+// expected to propose, or to every node, for them to be included timely.
+type VoluntaryExitPool[VoluntaryExitT VoluntaryExit] struct {
+	mu    sync.RWMutex
+	exits map[math.ValidatorIndex]VoluntaryExitT
+}
+
+// NewVoluntaryExitPool creates a new, empty voluntary exit pool.
+func NewVoluntaryExitPool[
+	VoluntaryExitT VoluntaryExit,
+]() *VoluntaryExitPool[VoluntaryExitT] {
+	return &VoluntaryExitPool[VoluntaryExitT]{
+		exits: make(map[math.ValidatorIndex]VoluntaryExitT),
+	}
+}
+
+// Add inserts the exit into the pool. An exit for a validator that already
+// has one pending is ignored.
+func (p *VoluntaryExitPool[VoluntaryExitT]) Add(exit VoluntaryExitT) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.exits[exit.GetValidatorIndex()]; !ok {
+		p.exits[exit.GetValidatorIndex()] = exit
+	}
+}
+
+// Pending returns the pending exits, ordered by validator index.
+func (p *VoluntaryExitPool[VoluntaryExitT]) Pending() []VoluntaryExitT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	exits := make([]VoluntaryExitT, 0, len(p.exits))
+	for _, exit := range p.exits {
+		exits = append(exits, exit)
+	}
+	slices.SortFunc(exits, func(a, b VoluntaryExitT) int {
+		return cmp.Compare(a.GetValidatorIndex(), b.GetValidatorIndex())
+	})
+	return exits
+}
+
+// Remove drops the exits of the given validators from the pool.
+func (p *VoluntaryExitPool[VoluntaryExitT]) Remove(
+	indices ...math.ValidatorIndex,
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, index := range indices {
+		delete(p.exits, index)
+	}
+}
+
+// Len returns the number of pending exits.
+func (p *VoluntaryExitPool[VoluntaryExitT]) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return len(p.exits)
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pool"
+	"github.com/stretchr/testify/require"
+)
+
+type mockExit struct {
+	index math.ValidatorIndex
+	epoch math.Epoch
+}
+
+func (e *mockExit) GetValidatorIndex() math.ValidatorIndex {
+	return e.index
+}
+
+func TestVoluntaryExitPool(t *testing.T) {
+	p := pool.NewVoluntaryExitPool[*mockExit]()
+	require.Empty(t, p.Pending())
+
+	first := &mockExit{index: 7, epoch: 1}
+	p.Add(first)
+	p.Add(&mockExit{index: 2})
+	p.Add(&mockExit{index: 5})
+
+	// A second exit for the same validator is ignored.
+	p.Add(&mockExit{index: 7, epoch: 2})
+	require.Equal(t, 3, p.Len())
+
+	pending := p.Pending()
+	require.Len(t, pending, 3)
+	require.Equal(t, math.ValidatorIndex(2), pending[0].GetValidatorIndex())
+	require.Equal(t, math.ValidatorIndex(5), pending[1].GetValidatorIndex())
+	require.Same(t, first, pending[2])
+
+	p.Remove(2, 7, 9)
+	pending = p.Pending()
+	require.Len(t, pending, 1)
+	require.Equal(t, math.ValidatorIndex(5), pending[0].GetValidatorIndex())
+}
@@ -30,7 +30,6 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/log"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 )
 
 // Compile-time check to ensure pruner implements the Pruner interface.
@@ -52,7 +51,8 @@ type pruner[
 	pruneRangeFn func(BlockEventT) (uint64, uint64)
 }
 
-// NewPruner creates a new Pruner.
+// NewPruner creates a new Pruner. The feed is expected to only deliver
+// finalized block events, each of which triggers a prune.
 func NewPruner[
 	BeaconBlockT BeaconBlock,
 	BlockEventT BlockEvent[BeaconBlockT],
@@ -85,11 +85,9 @@ func (p *pruner[_, _, _]) start(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case event := <-p.feed:
-			if event.Is(events.BeaconBlockFinalized) {
-				start, end := p.pruneRangeFn(event)
-				if err := p.prunable.Prune(start, end); err != nil {
-					p.logger.Error("‼️ error pruning index ‼️", "error", err)
-				}
+			start, end := p.pruneRangeFn(event)
+			if err := p.prunable.Prune(start, end); err != nil {
+				p.logger.Error("‼️ error pruning index ‼️", "error", err)
 			}
 		}
 	}
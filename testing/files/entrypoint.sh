@@ -47,6 +47,12 @@ GENESIS=$HOMEDIR/config/genesis.json
 TMP_GENESIS=$HOMEDIR/config/tmp_genesis.json
 ETH_GENESIS=$(resolve_path "./testing/files/eth-genesis.json")
 
+# Select the chain spec if one is given, otherwise the default is used
+CHAIN_SPEC_FLAG=""
+if [ -n "$CHAIN_SPEC" ]; then
+	CHAIN_SPEC_FLAG="--beacon-kit.chain-spec $CHAIN_SPEC"
+fi
+
 # used to exit on first error (any non-zero exit code)
 set -e
 
@@ -73,9 +79,9 @@ if [[ $overwrite == "y" || $overwrite == "Y" ]]; then
 	if [ "$CHAIN_SPEC" == "testnet" ]; then
 		cp -f testing/networks/80084/*.toml testing/networks/80084/genesis.json ${HOMEDIR}/config
 	else
-		./build/bin/beacond genesis add-premined-deposit --home $HOMEDIR
-		./build/bin/beacond genesis collect-premined-deposits --home $HOMEDIR 
-		./build/bin/beacond genesis execution-payload "$ETH_GENESIS" --home $HOMEDIR
+		./build/bin/beacond genesis add-premined-deposit --home $HOMEDIR $CHAIN_SPEC_FLAG
+		./build/bin/beacond genesis collect-premined-deposits --home $HOMEDIR $CHAIN_SPEC_FLAG
+		./build/bin/beacond genesis execution-payload "$ETH_GENESIS" --home $HOMEDIR $CHAIN_SPEC_FLAG
 	fi
 fi
 
@@ -85,7 +91,7 @@ BEACON_START_CMD="./build/bin/beacond start --pruning=nothing "$TRACE" \
 --api.enable --api.swagger --minimum-gas-prices=0.0001abgt \
 --home $HOMEDIR --beacon-kit.engine.jwt-secret-path ${JWT_SECRET_PATH} \
 --beacon-kit.block-store-service.enabled --beacon-kit.block-store-service.pruner-enabled \
---beacon-kit.node-api.enabled --beacon-kit.node-api.logging" 
+--beacon-kit.node-api.enabled --beacon-kit.node-api.logging $CHAIN_SPEC_FLAG" 
 
 # Conditionally add the rpc-dial-url flag if RPC_DIAL_URL is not empty
 if [ -n "$RPC_DIAL_URL" ]; then